@@ -0,0 +1,291 @@
+package monies
+
+import (
+	"errors"
+	"math/big"
+	"math/rand"
+	"sort"
+)
+
+var (
+	ErrRemainderIndex = errors.New("remainder index out of range")
+	ErrZeroRatioSum   = errors.New("ratios sum up to zero")
+	ErrNegativeRatio  = errors.New("ratio must not be negative")
+)
+
+// RemainderStrategy decides which parties receive the minor units left over
+// when an amount is allocated in proportion to ratios.
+type RemainderStrategy interface {
+	// order returns the parties in the order they receive leftover units.
+	// When there are more units than parties in the order, it is repeated.
+	order(shares []share) ([]int, error)
+}
+
+// share describes a single party of an allocation.
+type share struct {
+	weight *big.Int
+	// remainder is the part of the exact share lost to truncation, scaled by
+	// the sum of weights so that remainders of all parties are comparable.
+	remainder *big.Int
+}
+
+var (
+	// RemainderToFirst gives one leftover unit to each party in order,
+	// starting with the first one. Allocate and Split use it.
+	RemainderToFirst RemainderStrategy = remainderInOrder{}
+	// RemainderToLast gives one leftover unit to each party in reverse
+	// order, starting with the last one.
+	RemainderToLast RemainderStrategy = remainderInOrder{reverse: true}
+	// LargestRemainder gives one leftover unit to each party in order of the
+	// fraction they lost to truncation, largest first (Hamilton's method).
+	// Ties go to the earlier party.
+	LargestRemainder RemainderStrategy = largestRemainder{}
+	// RemainderToLargestShare gives all leftover units to the party with the
+	// largest ratio. Ties go to the earlier party.
+	RemainderToLargestShare RemainderStrategy = remainderToLargestShare{}
+)
+
+// RemainderToIndex returns a strategy giving all leftover units to the party at index i.
+func RemainderToIndex(i int) RemainderStrategy {
+	return remainderToIndex(i)
+}
+
+// SeededRandomRemainder returns a strategy giving one leftover unit to each
+// party in an order shuffled with the given seed. The same seed always
+// produces the same allocation.
+func SeededRandomRemainder(seed int64) RemainderStrategy {
+	return seededRandomRemainder(seed)
+}
+
+type remainderInOrder struct {
+	reverse bool
+}
+
+func (s remainderInOrder) order(shares []share) ([]int, error) {
+	idx := make([]int, len(shares))
+	for i := range idx {
+		idx[i] = i
+		if s.reverse {
+			idx[i] = len(shares) - 1 - i
+		}
+	}
+
+	return idx, nil
+}
+
+type largestRemainder struct{}
+
+func (largestRemainder) order(shares []share) ([]int, error) {
+	idx, _ := RemainderToFirst.order(shares)
+	sort.SliceStable(idx, func(i, j int) bool {
+		return shares[idx[i]].remainder.Cmp(shares[idx[j]].remainder) > 0
+	})
+
+	return idx, nil
+}
+
+type remainderToLargestShare struct{}
+
+func (remainderToLargestShare) order(shares []share) ([]int, error) {
+	largest := 0
+	for i, s := range shares {
+		if s.weight.Cmp(shares[largest].weight) > 0 {
+			largest = i
+		}
+	}
+
+	return []int{largest}, nil
+}
+
+type remainderToIndex int
+
+func (s remainderToIndex) order(shares []share) ([]int, error) {
+	if int(s) < 0 || int(s) >= len(shares) {
+		return nil, ErrRemainderIndex
+	}
+
+	return []int{int(s)}, nil
+}
+
+type seededRandomRemainder int64
+
+func (s seededRandomRemainder) order(shares []share) ([]int, error) {
+	return rand.New(rand.NewSource(int64(s))).Perm(len(shares)), nil
+}
+
+// AllocateWith works like Allocate, but hands out leftover minor units
+// according to strategy. Ratios summing up beyond math.MaxInt64 give
+// ErrOverflow.
+func (m Money) AllocateWith(strategy RemainderStrategy, rs ...int) ([]Money, error) {
+	weights, err := intWeights(rs)
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := allocateInt(big.NewInt(m.amount), weights, strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.fromParts(parts), nil
+}
+
+// fromParts converts allocated parts of m back to Money. No part exceeds the
+// allocated amount, so every part fits into int64.
+func (m Money) fromParts(parts []*big.Int) []Money {
+	ms := make([]Money, len(parts))
+	for i, p := range parts {
+		ms[i] = Money{amount: p.Int64(), currency: m.currency}
+	}
+
+	return ms
+}
+
+// AllocateWith works like Allocate, but hands out leftover minor units
+// according to strategy. Ratios summing up beyond math.MaxInt64 give
+// ErrOverflow.
+func (m BigMoney) AllocateWith(strategy RemainderStrategy, rs ...int) ([]BigMoney, error) {
+	weights, err := intWeights(rs)
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := allocateInt(m.int(), weights, strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	ms := make([]BigMoney, len(parts))
+	for i, p := range parts {
+		ms[i] = BigMoney{amount: p, currency: m.currency}
+	}
+
+	return ms, nil
+}
+
+// AllocateRat works like Allocate, but takes exact weights such as 1/3 or
+// 33.33. Unlike integer ratios, the weights may sum up to any value.
+func (m Money) AllocateRat(ws ...*big.Rat) ([]Money, error) {
+	return m.AllocateRatWith(RemainderToFirst, ws...)
+}
+
+// AllocateRatWith works like AllocateRat, but hands out leftover minor units
+// according to strategy.
+func (m Money) AllocateRatWith(strategy RemainderStrategy, ws ...*big.Rat) ([]Money, error) {
+	weights, err := ratWeights(ws)
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := allocateInt(big.NewInt(m.amount), weights, strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.fromParts(parts), nil
+}
+
+// AllocateDecimal works like AllocateRat, but takes the weights as decimal
+// strings such as "33.33".
+func (m Money) AllocateDecimal(ws ...string) ([]Money, error) {
+	rs := make([]*big.Rat, len(ws))
+	for i, w := range ws {
+		r, err := parseDecimal(w)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+
+	return m.AllocateRat(rs...)
+}
+
+// intWeights converts integer ratios to weights. It returns ErrOverflow when
+// the ratios sum up beyond int64, which every allocation by integer ratios
+// refuses.
+func intWeights(rs []int) ([]*big.Int, error) {
+	sum := int64(0)
+	ws := make([]*big.Int, len(rs))
+	for i, r := range rs {
+		var ok bool
+		if sum, ok = checkedAdd(sum, int64(r)); !ok {
+			return nil, ErrOverflow
+		}
+		ws[i] = big.NewInt(int64(r))
+	}
+
+	return ws, nil
+}
+
+// ratWeights scales exact weights by the least common multiple of their
+// denominators, which turns them into integers of the same proportions.
+func ratWeights(rs []*big.Rat) ([]*big.Int, error) {
+	lcm := big.NewInt(1)
+	for _, r := range rs {
+		if r == nil {
+			return nil, ErrInvalidDecimal
+		}
+
+		gcd := new(big.Int).GCD(nil, nil, lcm, r.Denom())
+		lcm.Mul(lcm, new(big.Int).Quo(r.Denom(), gcd))
+	}
+
+	ws := make([]*big.Int, len(rs))
+	for i, r := range rs {
+		ws[i] = new(big.Int).Mul(r.Num(), new(big.Int).Quo(lcm, r.Denom()))
+	}
+
+	return ws, nil
+}
+
+// allocateInt splits amount in proportion to weights without losing a unit.
+// Every party first receives its exact share truncated towards zero, then
+// strategy decides who receives the units left over. Weights must not be
+// negative and must not all be zero.
+func allocateInt(amount *big.Int, weights []*big.Int, strategy RemainderStrategy) ([]*big.Int, error) {
+	if len(weights) == 0 {
+		return nil, ErrNoRatios
+	}
+
+	if strategy == nil {
+		strategy = RemainderToFirst
+	}
+
+	sum := new(big.Int)
+	for _, w := range weights {
+		if w.Sign() < 0 {
+			return nil, ErrNegativeRatio
+		}
+		sum.Add(sum, w)
+	}
+
+	if sum.Sign() == 0 {
+		return nil, ErrZeroRatioSum
+	}
+
+	parts := make([]*big.Int, len(weights))
+	shares := make([]share, len(weights))
+	leftover := new(big.Int).Set(amount)
+	for i, w := range weights {
+		p, r := new(big.Int).QuoRem(new(big.Int).Mul(amount, w), sum, new(big.Int))
+		parts[i] = p
+		shares[i] = share{weight: w, remainder: r.Abs(r)}
+		leftover.Sub(leftover, p)
+	}
+
+	// The strategy is consulted even without leftover units, so that an
+	// invalid strategy fails whatever the amount.
+	order, err := strategy.order(shares)
+	if err != nil {
+		return nil, err
+	}
+
+	unit := big.NewInt(int64(leftover.Sign()))
+	for k := 0; leftover.Sign() != 0; k++ {
+		p := parts[order[k%len(order)]]
+		p.Add(p, unit)
+		leftover.Sub(leftover, unit)
+	}
+
+	return parts, nil
+}
@@ -0,0 +1,225 @@
+package monies_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/Craftserve/monies"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllocateWith(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Money       monies.Money
+		Strategy    monies.RemainderStrategy
+		Ratios      []int
+		Expected    []int64
+		ExpectedErr error
+	}{
+		{"FIRST", monies.MustNew(10, monies.EUR), monies.RemainderToFirst, []int{1, 1, 98}, []int64{1, 0, 9}, nil},
+		{"FIRST_NIL", monies.MustNew(100, monies.EUR), nil, []int{1, 1, 1}, []int64{34, 33, 33}, nil},
+		{"LAST", monies.MustNew(100, monies.EUR), monies.RemainderToLast, []int{1, 1, 1}, []int64{33, 33, 34}, nil},
+		{"LAST_NEGATIVE", monies.MustNew(-101, monies.EUR), monies.RemainderToLast, []int{1, 1, 1}, []int64{-33, -34, -34}, nil},
+		{"LARGEST_REMAINDER", monies.MustNew(10, monies.EUR), monies.LargestRemainder, []int{1, 1, 98}, []int64{0, 0, 10}, nil},
+		{"LARGEST_REMAINDER_TIE", monies.MustNew(2, monies.EUR), monies.LargestRemainder, []int{1, 1, 1}, []int64{1, 1, 0}, nil},
+		{"LARGEST_REMAINDER_ORDER", monies.MustNew(10, monies.EUR), monies.LargestRemainder, []int{15, 34, 51}, []int64{2, 3, 5}, nil},
+		{"LARGEST_SHARE", monies.MustNew(11, monies.EUR), monies.RemainderToLargestShare, []int{1, 1, 98}, []int64{0, 0, 11}, nil},
+		{"LARGEST_SHARE_TIE", monies.MustNew(6, monies.EUR), monies.RemainderToLargestShare, []int{1, 2, 2}, []int64{1, 3, 2}, nil},
+		{"INDEX", monies.MustNew(100, monies.EUR), monies.RemainderToIndex(1), []int{1, 1, 1}, []int64{33, 34, 33}, nil},
+		{"INDEX_ALL_UNITS", monies.MustNew(5, monies.EUR), monies.RemainderToIndex(0), []int{1, 1, 1, 1}, []int64{2, 1, 1, 1}, nil},
+		{"INDEX_OUT_OF_RANGE", monies.MustNew(100, monies.EUR), monies.RemainderToIndex(3), []int{1, 1, 1}, nil, monies.ErrRemainderIndex},
+		{"INDEX_UNUSED", monies.MustNew(99, monies.EUR), monies.RemainderToIndex(3), []int{1, 1, 1}, nil, monies.ErrRemainderIndex},
+		{"NO_RATIOS", monies.MustNew(100, monies.EUR), monies.LargestRemainder, []int{}, nil, monies.ErrNoRatios},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Name, func(t *testing.T) {
+			result, err := tC.Money.AllocateWith(tC.Strategy, tC.Ratios...)
+			assert.ErrorIs(t, err, tC.ExpectedErr)
+
+			if tC.ExpectedErr == nil {
+				var rs []int64
+				for _, party := range result {
+					rs = append(rs, party.Amount())
+				}
+
+				assert.Equal(t, tC.Expected, rs)
+			}
+		})
+	}
+}
+
+func TestSeededRandomRemainderIsDeterministic(t *testing.T) {
+	m := monies.MustNew(1003, monies.EUR)
+	ratios := []int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+
+	first, err := m.AllocateWith(monies.SeededRandomRemainder(42), ratios...)
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		again, err := m.AllocateWith(monies.SeededRandomRemainder(42), ratios...)
+		require.NoError(t, err)
+		assert.Equal(t, first, again)
+	}
+
+	// Different seeds should not always favour the same parties.
+	favoured := map[string]bool{}
+	for seed := int64(0); seed < 20; seed++ {
+		result, err := m.AllocateWith(monies.SeededRandomRemainder(seed), ratios...)
+		require.NoError(t, err)
+		favoured[fmt.Sprint(result)] = true
+	}
+	assert.Greater(t, len(favoured), 1)
+}
+
+func TestAllocateInvariants(t *testing.T) {
+	// MaxDeviation bounds how far each part may be from its exact share:
+	// 1 allows at most one leftover unit per party, 0 requires strictly less
+	// than one unit and -1 disables the check.
+	strategies := map[string]struct {
+		Strategy     monies.RemainderStrategy
+		MaxDeviation int
+	}{
+		"FIRST":             {monies.RemainderToFirst, 1},
+		"LAST":              {monies.RemainderToLast, 1},
+		"LARGEST_REMAINDER": {monies.LargestRemainder, 0},
+		"LARGEST_SHARE":     {monies.RemainderToLargestShare, -1},
+		"INDEX":             {monies.RemainderToIndex(0), -1},
+		"RANDOM":            {monies.SeededRandomRemainder(7), 1},
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	amounts := []int64{0, 1, -1, 7, -7, 100, -101, 999999, math.MaxInt64, math.MinInt64}
+	for i := 0; i < 50; i++ {
+		amounts = append(amounts, rnd.Int63()-rnd.Int63())
+	}
+
+	for name, s := range strategies {
+		t.Run(name, func(t *testing.T) {
+			for _, amount := range amounts {
+				n := 1 + rnd.Intn(8)
+				rs := make([]int, n)
+				sum := 0
+				for i := range rs {
+					rs[i] = rnd.Intn(100)
+					sum += rs[i]
+				}
+				if sum == 0 {
+					rs[0] = 1
+					sum = 1
+				}
+
+				m := monies.MustNew(amount, monies.EUR)
+				parts, err := m.AllocateWith(s.Strategy, rs...)
+				require.NoError(t, err)
+				require.Len(t, parts, n)
+
+				total := new(big.Int)
+				for i, p := range parts {
+					total.Add(total, big.NewInt(p.Amount()))
+					assert.True(t, p.SameCurrency(m))
+					assert.False(t, p.IsPositive() && amount < 0 || p.IsNegative() && amount > 0, "part %d of %d has wrong sign", i, amount)
+
+					if s.MaxDeviation >= 0 {
+						exact := new(big.Rat).SetFrac(new(big.Int).Mul(big.NewInt(amount), big.NewInt(int64(rs[i]))), big.NewInt(int64(sum)))
+						diff := new(big.Rat).Sub(exact, new(big.Rat).SetInt64(p.Amount()))
+						assert.Less(t, diff.Abs(diff).Cmp(big.NewRat(1, 1)), s.MaxDeviation, "part %d of %d with ratios %v", i, amount, rs)
+					}
+				}
+
+				assert.Equal(t, big.NewInt(amount).String(), total.String(), "ratios %v", rs)
+			}
+		})
+	}
+}
+
+func TestBigMoneyAllocateWith(t *testing.T) {
+	m := monies.MustNewBig(bigInt(t, "100000000000000000000"), monies.EUR)
+
+	result, err := m.AllocateWith(monies.RemainderToLast, 1, 1, 1)
+	require.NoError(t, err)
+
+	var rs []string
+	for _, party := range result {
+		rs = append(rs, party.Amount().String())
+	}
+	assert.Equal(t, []string{"33333333333333333333", "33333333333333333333", "33333333333333333334"}, rs)
+}
+
+func TestAllocateValidation(t *testing.T) {
+	m := monies.MustNew(100, monies.EUR)
+
+	_, err := m.Allocate(0, 0)
+	assert.ErrorIs(t, err, monies.ErrZeroRatioSum)
+
+	_, err = m.Allocate(50, -10, 60)
+	assert.ErrorIs(t, err, monies.ErrNegativeRatio)
+
+	_, err = m.Big().Allocate(0)
+	assert.ErrorIs(t, err, monies.ErrZeroRatioSum)
+
+	_, err = m.AllocateCash(-1, 2)
+	assert.ErrorIs(t, err, monies.ErrNegativeRatio)
+
+	result, err := m.Allocate(0, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []monies.Money{monies.MustNew(0, monies.EUR), m}, result)
+}
+
+func TestAllocateDecimal(t *testing.T) {
+	testCases := []struct {
+		Money       monies.Money
+		Weights     []string
+		Expected    []int64
+		ExpectedErr error
+	}{
+		{monies.MustNew(10000, monies.EUR), []string{"33.33", "33.33", "33.34"}, []int64{3333, 3333, 3334}, nil},
+		{monies.MustNew(100, monies.EUR), []string{"33.33", "33.33", "33.34"}, []int64{34, 33, 33}, nil},
+		{monies.MustNew(100, monies.EUR), []string{"0.5", "1.5"}, []int64{25, 75}, nil},
+		{monies.MustNew(-7, monies.EUR), []string{"0.1", "0.25"}, []int64{-2, -5}, nil},
+		{monies.MustNew(100, monies.EUR), []string{"0", "0.00"}, nil, monies.ErrZeroRatioSum},
+		{monies.MustNew(100, monies.EUR), []string{"1", "-0.5"}, nil, monies.ErrNegativeRatio},
+		{monies.MustNew(100, monies.EUR), []string{"1", "1/2"}, nil, monies.ErrInvalidDecimal},
+		{monies.MustNew(100, monies.EUR), []string{}, nil, monies.ErrNoRatios},
+	}
+
+	for index, tC := range testCases {
+		t.Run(fmt.Sprintf("#%d", index), func(t *testing.T) {
+			result, err := tC.Money.AllocateDecimal(tC.Weights...)
+			assert.ErrorIs(t, err, tC.ExpectedErr)
+
+			if tC.ExpectedErr == nil {
+				var rs []int64
+				for _, party := range result {
+					rs = append(rs, party.Amount())
+				}
+
+				assert.Equal(t, tC.Expected, rs)
+			}
+		})
+	}
+}
+
+func TestAllocateRat(t *testing.T) {
+	m := monies.MustNew(100, monies.EUR)
+
+	result, err := m.AllocateRat(big.NewRat(1, 3), big.NewRat(1, 6), big.NewRat(1, 2))
+	require.NoError(t, err)
+	assert.Equal(t, []monies.Money{monies.MustNew(34, monies.EUR), monies.MustNew(16, monies.EUR), monies.MustNew(50, monies.EUR)}, result)
+
+	result, err = m.AllocateRatWith(monies.LargestRemainder, big.NewRat(1, 3), big.NewRat(1, 6), big.NewRat(1, 2))
+	require.NoError(t, err)
+	assert.Equal(t, []monies.Money{monies.MustNew(33, monies.EUR), monies.MustNew(17, monies.EUR), monies.MustNew(50, monies.EUR)}, result)
+
+	result, err = m.AllocateRat(big.NewRat(math.MaxInt64, 1), big.NewRat(1, 1))
+	require.NoError(t, err)
+	assert.Equal(t, []monies.Money{m, monies.MustNew(0, monies.EUR)}, result)
+
+	_, err = m.AllocateRat(big.NewRat(1, 3), nil)
+	assert.ErrorIs(t, err, monies.ErrInvalidDecimal)
+}
@@ -0,0 +1,293 @@
+package monies
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+)
+
+// BigMoney represents a monetary value of arbitrary precision. It mirrors
+// Money for amounts that do not fit into int64 minor units.
+type BigMoney struct {
+	amount   *big.Int
+	currency *Currency
+}
+
+// NewBig creates and returns new instance of BigMoney. The amount is copied, nil stands for zero.
+func NewBig(amount *big.Int, code CurrencyCode) (m BigMoney, err error) {
+	currency, err := defaultRegistry.forNew(code)
+	if err != nil {
+		return m, err
+	}
+
+	a := new(big.Int)
+	if amount != nil {
+		a.Set(amount)
+	}
+
+	return BigMoney{
+		amount:   a,
+		currency: currency,
+	}, nil
+}
+
+// Big returns m as BigMoney.
+func (m Money) Big() BigMoney {
+	return BigMoney{amount: big.NewInt(m.amount), currency: m.currency}
+}
+
+// Money returns m as Money or ErrOverflow if the amount does not fit into int64.
+func (m BigMoney) Money() (Money, error) {
+	a := m.int()
+	if !a.IsInt64() {
+		return Money{}, ErrOverflow
+	}
+
+	return Money{amount: a.Int64(), currency: m.currency}, nil
+}
+
+func (m BigMoney) Currency() Currency {
+	return *m.cur()
+}
+
+// Amount returns a copy of the amount in minor units.
+func (m BigMoney) Amount() *big.Int {
+	return new(big.Int).Set(m.int())
+}
+
+// int returns the amount, treating the zero value of BigMoney as zero.
+func (m BigMoney) int() *big.Int {
+	if m.amount == nil {
+		return new(big.Int)
+	}
+
+	return m.amount
+}
+
+// cur returns the currency of m, which is never nil.
+func (m BigMoney) cur() *Currency {
+	if m.currency == nil {
+		return &noCurrency
+	}
+
+	return m.currency
+}
+
+func (m BigMoney) String() string {
+	return string(m.AppendString(nil))
+}
+
+// AppendString appends the String form of m to dst and returns the extended
+// buffer.
+func (m BigMoney) AppendString(dst []byte) []byte {
+	a := m.int()
+	return appendAmount(dst, new(big.Int).Abs(a).Append(nil, 10), a.Sign() < 0, m.cur())
+}
+
+func (m BigMoney) AsMajorUnits() float64 {
+	f := new(big.Float).SetInt(m.int())
+	if m.cur().Fraction > 0 {
+		exp := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(m.cur().Fraction)), nil)
+		f.Quo(f, new(big.Float).SetInt(exp))
+	}
+
+	r, _ := f.Float64()
+	return r
+}
+
+func (m *BigMoney) UnmarshalJSON(b []byte) error {
+	type moneyJSON struct {
+		Currency CurrencyCode `json:"currency"`
+		Amount   *big.Int     `json:"amount"`
+	}
+
+	var ref moneyJSON
+	err := json.Unmarshal(b, &ref)
+	if err != nil {
+		return err
+	}
+
+	if ref.Amount == nil {
+		ref.Amount = new(big.Int)
+	}
+
+	currency, err := defaultRegistry.handle(ref.Currency)
+	if err != nil {
+		return err
+	}
+
+	*m = BigMoney{amount: ref.Amount, currency: currency}
+	return nil
+}
+
+func (m BigMoney) MarshalJSON() ([]byte, error) {
+	return m.AppendJSON(nil), nil
+}
+
+// AppendJSON appends the MarshalJSON form of m to dst and returns the
+// extended buffer.
+func (m BigMoney) AppendJSON(dst []byte) []byte {
+	return appendJSON(dst, m.int().Append(nil, 10), m.cur())
+}
+
+func (m *BigMoney) UnmarshalText(text []byte) error {
+	currency, majorUnitsStr, minorUnitsStr, err := defaultRegistry.splitText(text)
+	if err != nil {
+		return err
+	}
+
+	majorUnits, ok := new(big.Int).SetString(majorUnitsStr, 10)
+	if !ok {
+		return ErrInvalidText
+	}
+
+	minorUnits, ok := new(big.Int).SetString(minorUnitsStr, 10)
+	if !ok {
+		return ErrInvalidText
+	}
+
+	if strings.HasPrefix(majorUnitsStr, "-") {
+		minorUnits.Neg(minorUnits)
+	}
+
+	amount := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(currency.Fraction)), nil)
+	amount.Mul(amount, majorUnits).Add(amount, minorUnits)
+
+	*m = BigMoney{amount: amount, currency: currency}
+
+	return nil
+}
+
+func (m BigMoney) MarshalText() ([]byte, error) {
+	return m.AppendText(nil), nil
+}
+
+// AppendText appends the MarshalText form of m to dst and returns the
+// extended buffer.
+func (m BigMoney) AppendText(dst []byte) []byte {
+	a := m.int()
+	return appendText(dst, new(big.Int).Abs(a).Append(nil, 10), a.Sign() < 0, m.cur())
+}
+
+func (m BigMoney) SameCurrency(om BigMoney) bool {
+	return m.currency == om.currency || m.cur().Code == om.cur().Code
+}
+
+func (m BigMoney) assertSameCurrency(om BigMoney) error {
+	if !m.SameCurrency(om) {
+		return ErrCurrencyMismatch
+	}
+
+	return nil
+}
+
+// Compare methods
+
+func (m BigMoney) Equals(om BigMoney) (bool, error) {
+	if err := m.assertSameCurrency(om); err != nil {
+		return false, err
+	}
+
+	return m.int().Cmp(om.int()) == 0, nil
+}
+
+func (m BigMoney) Less(om BigMoney) (bool, error) {
+	if err := m.assertSameCurrency(om); err != nil {
+		return false, err
+	}
+
+	return m.int().Cmp(om.int()) < 0, nil
+}
+
+// Asserts
+
+func (m BigMoney) IsZero() bool {
+	return m.int().Sign() == 0
+}
+
+func (m BigMoney) IsPositive() bool {
+	return m.int().Sign() > 0
+}
+
+func (m BigMoney) IsNegative() bool {
+	return m.int().Sign() < 0
+}
+
+// Operations
+
+func (m BigMoney) Absolute() BigMoney {
+	return BigMoney{amount: new(big.Int).Abs(m.int()), currency: m.currency}
+}
+
+func (m BigMoney) Negative() BigMoney {
+	a := new(big.Int).Abs(m.int())
+	return BigMoney{amount: a.Neg(a), currency: m.currency}
+}
+
+func (m BigMoney) Add(om BigMoney) (BigMoney, error) {
+	if err := m.assertSameCurrency(om); err != nil {
+		return om, err
+	}
+
+	return BigMoney{amount: new(big.Int).Add(m.int(), om.int()), currency: m.currency}, nil
+}
+
+func (m BigMoney) Subtract(om BigMoney) (BigMoney, error) {
+	if err := m.assertSameCurrency(om); err != nil {
+		return om, err
+	}
+
+	return BigMoney{amount: new(big.Int).Sub(m.int(), om.int()), currency: m.currency}, nil
+}
+
+func (m BigMoney) Multiply(mul int64) BigMoney {
+	return BigMoney{amount: new(big.Int).Mul(m.int(), big.NewInt(mul)), currency: m.currency}
+}
+
+// Helpers
+
+// Split tries to evenly distribute the value of the BigMoney struct among the parties.
+// If there are not enough pennies to fully distribute, the remainder will be distributed round-robin amongst the parties.
+func (m BigMoney) Split(n int) ([]BigMoney, error) {
+	if n <= 0 {
+		return nil, ErrNegativeSplit
+	}
+
+	a, r := new(big.Int).QuoRem(m.int(), big.NewInt(int64(n)), new(big.Int))
+	ms := make([]BigMoney, n)
+
+	// Add leftovers to the first parties.
+	l := r.Int64()
+	for i := range ms {
+		party := new(big.Int).Set(a)
+		switch {
+		case l > 0:
+			party.Add(party, bigOne)
+			l--
+		case l < 0:
+			party.Sub(party, bigOne)
+			l++
+		}
+
+		ms[i] = BigMoney{amount: party, currency: m.currency}
+	}
+
+	return ms, nil
+}
+
+// Allocate returns slice of BigMoney structs with split Self value in given Ratios.
+// It lets split money by given Ratios without losing pennies and as Split operations distributes
+// leftover pennies amongst the parties with round-robin principle.
+func (m BigMoney) Allocate(rs ...int) ([]BigMoney, error) {
+	return m.AllocateWith(RemainderToFirst, rs...)
+}
+
+// It panics if error occurs.
+func MustNewBig(amount *big.Int, code CurrencyCode) BigMoney {
+	m, err := NewBig(amount, code)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
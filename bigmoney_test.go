@@ -0,0 +1,218 @@
+package monies_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/Craftserve/monies"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func bigInt(t *testing.T, s string) *big.Int {
+	i, ok := new(big.Int).SetString(s, 10)
+	require.True(t, ok, s)
+
+	return i
+}
+
+func TestNewBig(t *testing.T) {
+	m, err := monies.NewBig(big.NewInt(100), monies.EUR)
+	assert.NoError(t, err)
+	assert.Equal(t, "100", m.Amount().String())
+	assert.Equal(t, monies.EUR, m.Currency().Code)
+
+	_, err = monies.NewBig(big.NewInt(100), "UNDEFINED_CURRENCY")
+	assert.ErrorIs(t, err, monies.ErrCurrencyNotFound)
+
+	m, err = monies.NewBig(nil, monies.EUR)
+	assert.NoError(t, err)
+	assert.True(t, m.IsZero())
+
+	assert.True(t, monies.BigMoney{}.IsZero())
+}
+
+func TestBigMoneyAmountIsCopied(t *testing.T) {
+	amount := big.NewInt(100)
+	m := monies.MustNewBig(amount, monies.EUR)
+
+	amount.SetInt64(200)
+	m.Amount().SetInt64(300)
+
+	assert.Equal(t, "100", m.Amount().String())
+}
+
+func TestBigMoneyConversion(t *testing.T) {
+	for _, amount := range []int64{0, 1, -1, math.MaxInt64, math.MinInt64} {
+		m := monies.MustNew(amount, monies.IRR)
+		back, err := m.Big().Money()
+		assert.NoError(t, err)
+		assert.Equal(t, m, back)
+	}
+
+	huge := monies.MustNewBig(bigInt(t, "9223372036854775808"), monies.IRR)
+	_, err := huge.Money()
+	assert.ErrorIs(t, err, monies.ErrOverflow)
+}
+
+func TestBigMoneyArithmetic(t *testing.T) {
+	max := monies.MustNew(math.MaxInt64, monies.VND).Big()
+
+	sum, err := max.Add(max)
+	assert.NoError(t, err)
+	assert.Equal(t, "18446744073709551614", sum.Amount().String())
+
+	diff, err := sum.Subtract(max)
+	assert.NoError(t, err)
+	assert.Equal(t, max.Amount(), diff.Amount())
+
+	assert.Equal(t, "-27670116110564327421", max.Multiply(-3).Amount().String())
+	assert.Equal(t, "27670116110564327421", max.Multiply(-3).Absolute().Amount().String())
+	assert.Equal(t, "-9223372036854775807", max.Negative().Amount().String())
+
+	_, err = max.Add(monies.MustNew(1, monies.USD).Big())
+	assert.ErrorIs(t, err, monies.ErrCurrencyMismatch)
+
+	_, err = max.Subtract(monies.MustNew(1, monies.USD).Big())
+	assert.ErrorIs(t, err, monies.ErrCurrencyMismatch)
+
+	equals, err := sum.Equals(max.Multiply(2))
+	assert.NoError(t, err)
+	assert.True(t, equals)
+
+	less, err := max.Less(sum)
+	assert.NoError(t, err)
+	assert.True(t, less)
+
+	assert.True(t, sum.IsPositive())
+	assert.True(t, sum.Negative().IsNegative())
+}
+
+func TestBigMoneySplit(t *testing.T) {
+	testCases := []struct {
+		Money       monies.BigMoney
+		Split       int
+		Expected    []string
+		ExpectedErr error
+	}{
+		{monies.MustNew(100, monies.EUR).Big(), 3, []string{"34", "33", "33"}, nil},
+		{monies.MustNew(-101, monies.EUR).Big(), 4, []string{"-26", "-25", "-25", "-25"}, nil},
+		{monies.MustNewBig(bigInt(t, "100000000000000000000"), monies.EUR), 3, []string{"33333333333333333334", "33333333333333333333", "33333333333333333333"}, nil},
+		{monies.MustNew(100, monies.EUR).Big(), 0, nil, monies.ErrNegativeSplit},
+	}
+
+	for index, tC := range testCases {
+		t.Run(fmt.Sprintf("#%d", index), func(t *testing.T) {
+			result, err := tC.Money.Split(tC.Split)
+			assert.ErrorIs(t, err, tC.ExpectedErr)
+
+			if tC.ExpectedErr == nil {
+				var rs []string
+				for _, party := range result {
+					rs = append(rs, party.Amount().String())
+				}
+
+				assert.Equal(t, tC.Expected, rs)
+			}
+		})
+	}
+}
+
+func TestBigMoneyAllocate(t *testing.T) {
+	testCases := []struct {
+		Money       monies.BigMoney
+		Ratios      []int
+		Expected    []string
+		ExpectedErr error
+	}{
+		{monies.MustNew(100, monies.EUR).Big(), []int{30, 30, 30}, []string{"34", "33", "33"}, nil},
+		{monies.MustNew(5, monies.EUR).Big(), []int{50, 25, 25}, []string{"3", "1", "1"}, nil},
+		{monies.MustNew(-101, monies.EUR).Big(), []int{50, 50}, []string{"-51", "-50"}, nil},
+		{monies.MustNewBig(bigInt(t, "100000000000000000001"), monies.EUR), []int{1, 1}, []string{"50000000000000000001", "50000000000000000000"}, nil},
+		{monies.MustNew(100, monies.EUR).Big(), []int{}, nil, monies.ErrNoRatios},
+		{monies.MustNew(100, monies.EUR).Big(), []int{math.MaxInt64, 1}, nil, monies.ErrOverflow},
+	}
+
+	for index, tC := range testCases {
+		t.Run(fmt.Sprintf("#%d", index), func(t *testing.T) {
+			result, err := tC.Money.Allocate(tC.Ratios...)
+			assert.ErrorIs(t, err, tC.ExpectedErr)
+
+			if tC.ExpectedErr == nil {
+				var rs []string
+				for _, party := range result {
+					rs = append(rs, party.Amount().String())
+				}
+
+				assert.Equal(t, tC.Expected, rs)
+			}
+		})
+	}
+}
+
+func TestBigMoneyDisplay(t *testing.T) {
+	testCases := []struct {
+		m        monies.BigMoney
+		expected string
+	}{
+		{monies.MustNew(100, monies.GBP).Big(), "£1.00"},
+		{monies.MustNew(-100, monies.GBP).Big(), "-£1.00"},
+		{monies.MustNewBig(bigInt(t, "123456789012345678901234"), monies.GBP), "£1,234,567,890,123,456,789,012.34"},
+	}
+
+	for _, tC := range testCases {
+		assert.Equal(t, tC.expected, tC.m.String())
+		assert.Equal(t, tC.expected, fmt.Sprint(tC.m))
+		assert.Equal(t, "prefix:"+tC.expected, string(tC.m.AppendString([]byte("prefix:"))))
+	}
+
+	assert.Equal(t, 1.5, monies.MustNew(150, monies.GBP).Big().AsMajorUnits())
+}
+
+func TestBigMoneyJSON(t *testing.T) {
+	m := monies.MustNewBig(bigInt(t, "123456789012345678901234"), monies.IRR)
+
+	b, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"amount":123456789012345678901234,"currency":"IRR"}`, string(b))
+	assert.Equal(t, `{"amount": 123456789012345678901234, "currency": "IRR"}`, string(m.AppendJSON(nil)))
+
+	var decoded monies.BigMoney
+	assert.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, m, decoded)
+
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"amount": 1, "currency": "UNDEFINED_CURRENCY"}`), &decoded), monies.ErrCurrencyNotFound)
+	assert.Error(t, json.Unmarshal([]byte(`{"amount": "foo", "currency": "USD"}`), &decoded))
+}
+
+func TestBigMoneyText(t *testing.T) {
+	testCases := []struct {
+		Money monies.BigMoney
+		Text  string
+	}{
+		{monies.MustNew(150, monies.USD).Big(), "1.50 USD"},
+		{monies.MustNew(-5, monies.USD).Big(), "-0.05 USD"},
+		{monies.MustNew(10000, monies.VND).Big(), "10000.0 VND"},
+		{monies.MustNewBig(bigInt(t, "123456789012345678901234"), monies.USD), "1234567890123456789012.34 USD"},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Text, func(t *testing.T) {
+			b, err := tC.Money.MarshalText()
+			assert.NoError(t, err)
+			assert.Equal(t, tC.Text, string(b))
+			assert.Equal(t, tC.Text, string(tC.Money.AppendText(nil)))
+
+			var decoded monies.BigMoney
+			assert.NoError(t, decoded.UnmarshalText(b))
+			assert.Equal(t, tC.Money, decoded)
+		})
+	}
+
+	var decoded monies.BigMoney
+	assert.Error(t, decoded.UnmarshalText([]byte("NULL.00 USD")))
+	assert.Error(t, decoded.UnmarshalText([]byte("1.00 UUU")))
+}
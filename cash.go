@@ -0,0 +1,90 @@
+package monies
+
+import "errors"
+
+var ErrNotCashAmount = errors.New("amount is not a multiple of the cash rounding increment")
+
+// RoundToCash rounds m to a multiple of its currency's CashRounding using mode.
+// Currencies without cash rounding are returned unchanged.
+func (m Money) RoundToCash(mode RoundingMode) (Money, error) {
+	if !mode.valid() {
+		return m, ErrInvalidRoundingMode
+	}
+
+	inc := m.cashIncrement()
+	if inc == 1 {
+		return m, nil
+	}
+
+	a, ok := checkedMul(divRound(m.amount, inc, mode), inc)
+	if !ok {
+		return m, ErrOverflow
+	}
+
+	return Money{amount: a, currency: m.currency}, nil
+}
+
+// IsCashAmount reports whether m can be paid in cash.
+func (m Money) IsCashAmount() bool {
+	return m.amount%m.cashIncrement() == 0
+}
+
+// SplitCash works like Split, but every part is a multiple of the currency's
+// CashRounding. m itself must be payable in cash, see RoundToCash.
+func (m Money) SplitCash(n int) ([]Money, error) {
+	units, err := m.cashUnits()
+	if err != nil {
+		return nil, err
+	}
+
+	ms, err := units.Split(n)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.fromCashUnits(ms), nil
+}
+
+// AllocateCash works like Allocate, but every part is a multiple of the
+// currency's CashRounding. m itself must be payable in cash, see RoundToCash.
+func (m Money) AllocateCash(rs ...int) ([]Money, error) {
+	units, err := m.cashUnits()
+	if err != nil {
+		return nil, err
+	}
+
+	ms, err := units.Allocate(rs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.fromCashUnits(ms), nil
+}
+
+func (m Money) cashIncrement() int64 {
+	if m.cur().CashRounding <= 1 {
+		return 1
+	}
+
+	return int64(m.cur().CashRounding)
+}
+
+// cashUnits returns m expressed in cash increments instead of minor units.
+func (m Money) cashUnits() (Money, error) {
+	if !m.IsCashAmount() {
+		return m, ErrNotCashAmount
+	}
+
+	return Money{amount: m.amount / m.cashIncrement(), currency: m.currency}, nil
+}
+
+// fromCashUnits converts parts produced from cashUnits back to minor units.
+// The parts never exceed m, so scaling them back cannot overflow.
+func (m Money) fromCashUnits(ms []Money) []Money {
+	inc := m.cashIncrement()
+	for i := range ms {
+		ms[i].amount *= inc
+	}
+
+	return ms
+}
@@ -0,0 +1,104 @@
+package monies_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Craftserve/monies"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundToCash(t *testing.T) {
+	testCases := []struct {
+		Money       monies.Money
+		Mode        monies.RoundingMode
+		Expected    int64
+		ExpectedErr error
+	}{
+		{monies.MustNew(1234, monies.CHF), monies.RoundHalfUp, 1235, nil},
+		{monies.MustNew(1232, monies.CHF), monies.RoundHalfUp, 1230, nil},
+		{monies.MustNew(-1233, monies.CHF), monies.RoundHalfUp, -1235, nil},
+		{monies.MustNew(1225, monies.DKK), monies.RoundHalfEven, 1200, nil},
+		{monies.MustNew(1275, monies.DKK), monies.RoundHalfEven, 1300, nil},
+		{monies.MustNew(1201, monies.SEK), monies.RoundCeiling, 1300, nil},
+		{monies.MustNew(1299, monies.SEK), monies.RoundFloor, 1200, nil},
+		{monies.MustNew(1234, monies.EUR), monies.RoundUp, 1234, nil},
+		{monies.MustNew(1234, monies.CHF), monies.RoundingMode(-1), 0, monies.ErrInvalidRoundingMode},
+	}
+
+	for index, tC := range testCases {
+		t.Run(fmt.Sprintf("#%d", index), func(t *testing.T) {
+			result, err := tC.Money.RoundToCash(tC.Mode)
+			assert.ErrorIs(t, err, tC.ExpectedErr)
+
+			if tC.ExpectedErr == nil {
+				assert.Equal(t, tC.Expected, result.Amount())
+				assert.True(t, result.IsCashAmount())
+			}
+		})
+	}
+}
+
+func TestSplitCash(t *testing.T) {
+	testCases := []struct {
+		Money       monies.Money
+		Split       int
+		Expected    []int64
+		ExpectedErr error
+	}{
+		{monies.MustNew(1000, monies.CHF), 3, []int64{335, 335, 330}, nil},
+		{monies.MustNew(-1000, monies.CHF), 3, []int64{-335, -335, -330}, nil},
+		{monies.MustNew(500, monies.SEK), 2, []int64{300, 200}, nil},
+		{monies.MustNew(100, monies.EUR), 3, []int64{34, 33, 33}, nil},
+		{monies.MustNew(1001, monies.CHF), 3, nil, monies.ErrNotCashAmount},
+		{monies.MustNew(1000, monies.CHF), 0, nil, monies.ErrNegativeSplit},
+	}
+
+	for index, tC := range testCases {
+		t.Run(fmt.Sprintf("#%d", index), func(t *testing.T) {
+			result, err := tC.Money.SplitCash(tC.Split)
+			assert.ErrorIs(t, err, tC.ExpectedErr)
+
+			if tC.ExpectedErr == nil {
+				var rs []int64
+				for _, party := range result {
+					rs = append(rs, party.Amount())
+				}
+
+				assert.Equal(t, tC.Expected, rs)
+			}
+		})
+	}
+}
+
+func TestAllocateCash(t *testing.T) {
+	testCases := []struct {
+		Money       monies.Money
+		Ratios      []int
+		Expected    []int64
+		ExpectedErr error
+	}{
+		{monies.MustNew(1000, monies.CHF), []int{1, 1, 1}, []int64{335, 335, 330}, nil},
+		{monies.MustNew(1000, monies.DKK), []int{70, 30}, []int64{700, 300}, nil},
+		{monies.MustNew(1050, monies.DKK), []int{50, 50}, []int64{550, 500}, nil},
+		{monies.MustNew(1001, monies.DKK), []int{50, 50}, nil, monies.ErrNotCashAmount},
+		{monies.MustNew(1000, monies.CHF), []int{}, nil, monies.ErrNoRatios},
+	}
+
+	for index, tC := range testCases {
+		t.Run(fmt.Sprintf("#%d", index), func(t *testing.T) {
+			result, err := tC.Money.AllocateCash(tC.Ratios...)
+			assert.ErrorIs(t, err, tC.ExpectedErr)
+
+			if tC.ExpectedErr == nil {
+				var rs []int64
+				for _, party := range result {
+					rs = append(rs, party.Amount())
+					assert.True(t, party.IsCashAmount())
+				}
+
+				assert.Equal(t, tC.Expected, rs)
+			}
+		})
+	}
+}
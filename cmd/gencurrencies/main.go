@@ -0,0 +1,367 @@
+// Command gencurrencies generates the built-in currency table of package
+// monies from a CSV data file.
+//
+// The data file has a header line followed by one currency per line, using
+// the columns read by monies.ReadCurrenciesCSV: code, numeric_code,
+// fraction, grapheme, pattern, decimal, thousand, cash_rounding, status,
+// introduced, withdrawn, replaced_by, name, minor_unit_name and countries. The
+// generated file holds the builtinCurrencies function returning the table
+// and a CurrencyCode constant for every currency.
+//
+// Usage:
+//
+//	gencurrencies -in data/currencies.csv -out currency_table.go
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// columns lists the columns of the data file in the order they must appear.
+var columns = []string{"code", "numeric_code", "fraction", "grapheme", "pattern", "decimal", "thousand", "cash_rounding",
+	"status", "introduced", "withdrawn", "replaced_by", "name", "minor_unit_name", "countries"}
+
+// dateLayout is the layout of the introduced and withdrawn columns.
+const dateLayout = "2006-01-02"
+
+// maxFraction mirrors the limit monies puts on Currency.Fraction.
+const maxFraction = 18
+
+type currency struct {
+	line         int
+	code         string
+	numericCode  string
+	fraction     int
+	grapheme     string
+	pattern      string
+	decimal      string
+	thousand     string
+	cashRounding int
+	withdrawn    bool
+	introducedAt time.Time
+	withdrawnAt  time.Time
+	replacedBy   string
+	name         string
+	minorUnit    string
+	countries    []string
+}
+
+func main() {
+	in := flag.String("in", "data/currencies.csv", "currency data file")
+	out := flag.String("out", "currency_table.go", "generated Go file")
+	flag.Parse()
+
+	f, err := os.Open(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	src, err := generate(f)
+	if err != nil {
+		log.Fatalf("%s: %v", *in, err)
+	}
+
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate reads the data file from r and returns the formatted source of
+// the currency table.
+func generate(r io.Reader) ([]byte, error) {
+	cs, err := read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := check(cs); err != nil {
+		return nil, err
+	}
+
+	src, err := format.Source(render(cs))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verify(src); err != nil {
+		return nil, err
+	}
+
+	return src, nil
+}
+
+func read(r io.Reader) ([]currency, error) {
+	// The header fixes the number of fields of every following record.
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	if strings.Join(header, ",") != strings.Join(columns, ",") {
+		return nil, fmt.Errorf("line 1: header must be %s", strings.Join(columns, ","))
+	}
+
+	var cs []currency
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := cr.FieldPos(0)
+		c := currency{
+			line:        line,
+			code:        record[0],
+			numericCode: record[1],
+			grapheme:    record[3],
+			pattern:     record[4],
+			decimal:     record[5],
+			thousand:    record[6],
+			replacedBy:  record[11],
+			name:        record[12],
+			minorUnit:   record[13],
+			countries:   strings.Fields(record[14]),
+		}
+
+		if c.fraction, err = strconv.Atoi(record[2]); err != nil {
+			return nil, fmt.Errorf("line %d: fraction: %v", line, err)
+		}
+		if record[7] != "" {
+			if c.cashRounding, err = strconv.Atoi(record[7]); err != nil {
+				return nil, fmt.Errorf("line %d: cash_rounding: %v", line, err)
+			}
+		}
+
+		switch record[8] {
+		case "", "active":
+		case "withdrawn":
+			c.withdrawn = true
+		default:
+			return nil, fmt.Errorf("line %d: unknown status %q", line, record[8])
+		}
+		if c.introducedAt, err = parseDate(record[9]); err != nil {
+			return nil, fmt.Errorf("line %d: introduced: %v", line, err)
+		}
+		if c.withdrawnAt, err = parseDate(record[10]); err != nil {
+			return nil, fmt.Errorf("line %d: withdrawn: %v", line, err)
+		}
+
+		cs = append(cs, c)
+	}
+
+	return cs, nil
+}
+
+func parseDate(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(dateLayout, s)
+}
+
+// check validates every currency and reports duplicate codes and numeric
+// codes.
+func check(cs []currency) error {
+	var errs []string
+	codes := make(map[string]int, len(cs))
+	numeric := make(map[string]string, len(cs))
+	for _, c := range cs {
+		fail := func(format string, args ...interface{}) {
+			errs = append(errs, fmt.Sprintf("line %d (%s): ", c.line, c.code)+fmt.Sprintf(format, args...))
+		}
+
+		if len(c.code) != 3 || strings.Trim(c.code, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+			fail("code must consist of three upper-case letters")
+		}
+		if c.numericCode != "" && (len(c.numericCode) != 3 || strings.Trim(c.numericCode, "0123456789") != "") {
+			fail("numeric code %q must consist of three digits", c.numericCode)
+		}
+		if c.fraction < 0 || c.fraction > maxFraction {
+			fail("fraction %d out of range [0, %d]", c.fraction, maxFraction)
+		}
+		if c.decimal == "" {
+			fail("decimal separator is required")
+		}
+		if !strings.ContainsAny(c.pattern, "#0") {
+			fail("pattern %q has no digits", c.pattern)
+		}
+		if c.cashRounding < 0 {
+			fail("negative cash rounding")
+		}
+		if !c.introducedAt.IsZero() && !c.withdrawnAt.IsZero() && !c.introducedAt.Before(c.withdrawnAt) {
+			fail("withdrawn %s before introduced %s", c.withdrawnAt.Format(dateLayout), c.introducedAt.Format(dateLayout))
+		}
+		if c.replacedBy == c.code {
+			fail("replaced by itself")
+		}
+		for _, country := range c.countries {
+			if len(country) != 2 || strings.Trim(country, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+				fail("country %q must consist of two upper-case letters", country)
+			}
+		}
+
+		if line, ok := codes[c.code]; ok {
+			fail("duplicate code, first defined on line %d", line)
+		}
+		codes[c.code] = c.line
+
+		if c.numericCode != "" {
+			if other, ok := numeric[c.numericCode]; ok {
+				fail("duplicate numeric code %s, also used by %s", c.numericCode, other)
+			}
+			numeric[c.numericCode] = c.code
+		}
+	}
+
+	for _, c := range cs {
+		if _, ok := codes[c.replacedBy]; c.replacedBy != "" && !ok {
+			errs = append(errs, fmt.Sprintf("line %d (%s): replaced by unknown currency %s", c.line, c.code, c.replacedBy))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "\n"))
+	}
+
+	return nil
+}
+
+func render(cs []currency) []byte {
+	sorted := append([]currency(nil), cs...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].code < sorted[j].code
+	})
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gencurrencies from data/currencies.csv; DO NOT EDIT.\n\n")
+	b.WriteString("package monies\n\n")
+	for _, c := range sorted {
+		if !c.introducedAt.IsZero() || !c.withdrawnAt.IsZero() {
+			b.WriteString("import \"time\"\n\n")
+			break
+		}
+	}
+
+	b.WriteString("// builtinCurrencies returns the built-in currencies. The table is not kept\n")
+	b.WriteString("// in a variable, so that the default registry is its only copy.\n")
+	b.WriteString("func builtinCurrencies() CurrenciesMap {\n")
+	b.WriteString("\treturn CurrenciesMap{\n")
+	for _, c := range sorted {
+		fmt.Fprintf(&b, "\t\t%s: {Decimal: %s, Thousand: %s, Code: %s, Fraction: %d, NumericCode: %q, Grapheme: %s, Pattern: %s",
+			c.code, strconv.QuoteToASCII(c.decimal), strconv.QuoteToASCII(c.thousand), c.code, c.fraction, c.numericCode,
+			strconv.QuoteToASCII(c.grapheme), strconv.QuoteToASCII(c.pattern))
+		if c.cashRounding != 0 {
+			fmt.Fprintf(&b, ", CashRounding: %d", c.cashRounding)
+		}
+		if c.withdrawn {
+			b.WriteString(", Status: CurrencyWithdrawn")
+		}
+		if !c.introducedAt.IsZero() {
+			fmt.Fprintf(&b, ", Introduced: %s", renderDate(c.introducedAt))
+		}
+		if !c.withdrawnAt.IsZero() {
+			fmt.Fprintf(&b, ", Withdrawn: %s", renderDate(c.withdrawnAt))
+		}
+		if c.replacedBy != "" {
+			fmt.Fprintf(&b, ", ReplacedBy: %s", c.replacedBy)
+		}
+		if c.name != "" {
+			fmt.Fprintf(&b, ", Name: %s", strconv.QuoteToASCII(c.name))
+		}
+		if c.minorUnit != "" {
+			fmt.Fprintf(&b, ", MinorUnitName: %s", strconv.QuoteToASCII(c.minorUnit))
+		}
+		if len(c.countries) > 0 {
+			fmt.Fprintf(&b, ", Countries: NewCountryList(%q", c.countries[0])
+			for _, country := range c.countries[1:] {
+				fmt.Fprintf(&b, ", %q", country)
+			}
+			b.WriteString(")")
+		}
+		b.WriteString("},\n")
+	}
+	b.WriteString("\t}\n")
+	b.WriteString("}\n\n")
+
+	b.WriteString("const (\n")
+	for _, c := range sorted {
+		fmt.Fprintf(&b, "\t%s CurrencyCode = %q\n", c.code, c.code)
+	}
+	b.WriteString(")\n")
+
+	return b.Bytes()
+}
+
+func renderDate(t time.Time) string {
+	return fmt.Sprintf("time.Date(%d, %d, %d, 0, 0, 0, 0, time.UTC)", t.Year(), t.Month(), t.Day())
+}
+
+// verify parses the generated source and checks that the constants and the
+// map keys name the same currencies.
+func verify(src []byte) error {
+	f, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		return err
+	}
+
+	consts := make(map[string]bool)
+	keys := make(map[string]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.GenDecl:
+			if n.Tok == token.CONST {
+				for _, spec := range n.Specs {
+					for _, name := range spec.(*ast.ValueSpec).Names {
+						consts[name.Name] = true
+					}
+				}
+			}
+		case *ast.CompositeLit:
+			if id, ok := n.Type.(*ast.Ident); !ok || id.Name != "CurrenciesMap" {
+				return true
+			}
+			for _, elt := range n.Elts {
+				if kv, ok := elt.(*ast.KeyValueExpr); ok {
+					if id, ok := kv.Key.(*ast.Ident); ok {
+						keys[id.Name] = true
+					}
+				}
+			}
+		}
+
+		return true
+	})
+
+	for name := range consts {
+		if !keys[name] {
+			return fmt.Errorf("constant %s has no currency entry", name)
+		}
+	}
+	for name := range keys {
+		if !consts[name] {
+			return fmt.Errorf("currency entry %s has no constant", name)
+		}
+	}
+
+	return nil
+}
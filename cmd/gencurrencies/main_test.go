@@ -0,0 +1,78 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const header = "code,numeric_code,fraction,grapheme,pattern,decimal,thousand,cash_rounding,status,introduced,withdrawn,replaced_by,name,minor_unit_name,countries\n"
+
+func TestGeneratedTableUpToDate(t *testing.T) {
+	f, err := os.Open("../../data/currencies.csv")
+	require.NoError(t, err)
+	defer f.Close()
+
+	src, err := generate(f)
+	require.NoError(t, err)
+
+	current, err := os.ReadFile("../../currency_table.go")
+	require.NoError(t, err)
+	assert.Equal(t, string(src), string(current), "currency_table.go is stale, run go generate")
+}
+
+func TestGenerate(t *testing.T) {
+	src, err := generate(strings.NewReader(header +
+		"PLN,985,2,zł,\"#,##0.00 ¤\",\",\", ,,,,,,Polish Złoty,grosz,PL\n" +
+		"CHF,756,2,CHF,\"#,##0.00 ¤\",.,',5,,,,,,,\n" +
+		"ZWD,716,2,Z$,\"¤#,##0.00;(¤#,##0.00)\",.,\",\",,withdrawn,1980-04-18,2009-02-02,PLN,,,\n"))
+	require.NoError(t, err)
+
+	s := string(src)
+	assert.Contains(t, s, `CHF: {Decimal: ".", Thousand: "'", Code: CHF, Fraction: 2, NumericCode: "756", Grapheme: "CHF", Pattern: "#,##0.00 \u00a4", CashRounding: 5},`)
+	assert.Contains(t, s, `PLN: {Decimal: ",", Thousand: " ", Code: PLN, Fraction: 2, NumericCode: "985", Grapheme: "z\u0142", Pattern: "#,##0.00 \u00a4", Name: "Polish Z\u0142oty", MinorUnitName: "grosz", Countries: NewCountryList("PL")},`)
+	assert.Less(t, strings.Index(s, "CHF:"), strings.Index(s, "PLN:"))
+	assert.Contains(t, s, `ZWD: {Decimal: ".", Thousand: ",", Code: ZWD, Fraction: 2, NumericCode: "716", Grapheme: "Z$", Pattern: "\u00a4#,##0.00;(\u00a4#,##0.00)", Status: CurrencyWithdrawn, Introduced: time.Date(1980, 4, 18, 0, 0, 0, 0, time.UTC), Withdrawn: time.Date(2009, 2, 2, 0, 0, 0, 0, time.UTC), ReplacedBy: PLN},`)
+	assert.Contains(t, s, `import "time"`)
+	assert.Contains(t, s, `PLN CurrencyCode = "PLN"`)
+}
+
+func TestGenerateErrors(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected string
+	}{
+		{"HEADER", "code,fraction\nPLN,2\n", "header must be"},
+		{"FRACTION", header + "PLN,985,two,zł,#0.00 ¤,.,,,,,,,,,\n", "line 2: fraction"},
+		{"DUPLICATE_CODE", header + "PLN,985,2,zł,#0.00 ¤,.,,,,,,,,,\nPLN,986,2,zł,#0.00 ¤,.,,,,,,,,,\n", "line 3 (PLN): duplicate code, first defined on line 2"},
+		{"DUPLICATE_NUMERIC_CODE", header + "VEF,928,2,Bs,¤#0.00,.,,,,,,,,,\nVES,928,2,Bs.S,¤#0.00,.,,,,,,,,,\n", "line 3 (VES): duplicate numeric code 928, also used by VEF"},
+		{"NUMERIC_CODE", header + "PLN,98,2,zł,#0.00 ¤,.,,,,,,,,,\n", "numeric code \"98\""},
+		{"PATTERN", header + "PLN,985,2,zł,¤,.,,,,,,,,,\n", "pattern \"¤\" has no digits"},
+		{"CODE", header + "pln,985,2,zł,#0.00 ¤,.,,,,,,,,,\n", "three upper-case letters"},
+		{"STATUS", header + "PLN,985,2,zł,#0.00 ¤,.,,,retired,,,,,,\n", "line 2: unknown status \"retired\""},
+		{"DATE", header + "PLN,985,2,zł,#0.00 ¤,.,,,,1950-13-01,,,,,\n", "line 2: introduced"},
+		{"PERIOD", header + "PLN,985,2,zł,#0.00 ¤,.,,,withdrawn,2009-02-02,1980-04-18,,,,\n", "withdrawn 1980-04-18 before introduced 2009-02-02"},
+		{"COUNTRY", header + "PLN,985,2,zł,#0.00 ¤,.,,,,,,,,,pl\n", "country \"pl\" must consist of two upper-case letters"},
+		{"REPLACED_BY", header + "PLN,985,2,zł,#0.00 ¤,.,,,withdrawn,,,XYZ,,,\n", "line 2 (PLN): replaced by unknown currency XYZ"},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Name, func(t *testing.T) {
+			_, err := generate(strings.NewReader(tC.Input))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tC.Expected)
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	err := verify([]byte("package monies\n\nvar currencies = CurrenciesMap{PLN: {}}\n\nconst (\n\tPLN CurrencyCode = \"PLN\"\n\tEUR CurrencyCode = \"EUR\"\n)\n"))
+	assert.EqualError(t, err, "constant EUR has no currency entry")
+
+	err = verify([]byte("package monies\n\nvar currencies = CurrenciesMap{PLN: {}, EUR: {}}\n\nconst PLN CurrencyCode = \"PLN\"\n"))
+	assert.EqualError(t, err, "currency entry EUR has no constant")
+}
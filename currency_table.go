@@ -0,0 +1,365 @@
+// Code generated by gencurrencies from data/currencies.csv; DO NOT EDIT.
+
+package monies
+
+import "time"
+
+// builtinCurrencies returns the built-in currencies. The table is not kept
+// in a variable, so that the default registry is its only copy.
+func builtinCurrencies() CurrenciesMap {
+	return CurrenciesMap{
+		AED: {Decimal: ".", Thousand: ",", Code: AED, Fraction: 2, NumericCode: "784", Grapheme: ".\u062f.\u0625", Pattern: "#,##0.00 \u00a4", Name: "UAE Dirham", MinorUnitName: "fils", Countries: NewCountryList("AE")},
+		AFN: {Decimal: ".", Thousand: ",", Code: AFN, Fraction: 2, NumericCode: "971", Grapheme: "\u060b", Pattern: "#,##0.00 \u00a4", Name: "Afghani", MinorUnitName: "pul", Countries: NewCountryList("AF")},
+		ALL: {Decimal: ".", Thousand: ",", Code: ALL, Fraction: 2, NumericCode: "008", Grapheme: "L", Pattern: "\u00a4#,##0.00", Name: "Lek", MinorUnitName: "qindarka", Countries: NewCountryList("AL")},
+		AMD: {Decimal: ".", Thousand: ",", Code: AMD, Fraction: 2, NumericCode: "051", Grapheme: "\u0564\u0580.", Pattern: "#,##0.00 \u00a4", Name: "Armenian Dram", MinorUnitName: "luma", Countries: NewCountryList("AM")},
+		ANG: {Decimal: ",", Thousand: ".", Code: ANG, Fraction: 2, NumericCode: "532", Grapheme: "\u0192", Pattern: "\u00a4#,##0.00", Name: "Netherlands Antillean Guilder", MinorUnitName: "cent", Countries: NewCountryList("CW", "SX")},
+		AOA: {Decimal: ".", Thousand: ",", Code: AOA, Fraction: 2, NumericCode: "973", Grapheme: "Kz", Pattern: "#,##0.00\u00a4", Name: "Kwanza", MinorUnitName: "c\u00eantimo", Countries: NewCountryList("AO")},
+		ARS: {Decimal: ".", Thousand: ",", Code: ARS, Fraction: 2, NumericCode: "032", Grapheme: "$", Pattern: "\u00a4#,##0.00", Name: "Argentine Peso", MinorUnitName: "centavo", Countries: NewCountryList("AR")},
+		AUD: {Decimal: ".", Thousand: ",", Code: AUD, Fraction: 2, NumericCode: "036", Grapheme: "$", Pattern: "\u00a4#,##0.00", CashRounding: 5, Name: "Australian Dollar", MinorUnitName: "cent", Countries: NewCountryList("AU", "CC", "CX", "HM", "KI", "NF", "NR", "TV")},
+		AWG: {Decimal: ".", Thousand: ",", Code: AWG, Fraction: 2, NumericCode: "533", Grapheme: "\u0192", Pattern: "#,##0.00\u00a4", Name: "Aruban Florin", MinorUnitName: "cent", Countries: NewCountryList("AW")},
+		AZN: {Decimal: ".", Thousand: ",", Code: AZN, Fraction: 2, NumericCode: "944", Grapheme: "\u20bc", Pattern: "\u00a4#,##0.00", Name: "Azerbaijan Manat", MinorUnitName: "q\u0259pik", Countries: NewCountryList("AZ")},
+		BAM: {Decimal: ".", Thousand: ",", Code: BAM, Fraction: 2, NumericCode: "977", Grapheme: "KM", Pattern: "\u00a4#,##0.00", Name: "Convertible Mark", MinorUnitName: "fening", Countries: NewCountryList("BA")},
+		BBD: {Decimal: ".", Thousand: ",", Code: BBD, Fraction: 2, NumericCode: "052", Grapheme: "$", Pattern: "\u00a4#,##0.00", Name: "Barbados Dollar", MinorUnitName: "cent", Countries: NewCountryList("BB")},
+		BDT: {Decimal: ".", Thousand: ",", Code: BDT, Fraction: 2, NumericCode: "050", Grapheme: "\u09f3", Pattern: "\u00a4#,##0.00", Name: "Taka", MinorUnitName: "poisha", Countries: NewCountryList("BD")},
+		BGN: {Decimal: ".", Thousand: ",", Code: BGN, Fraction: 2, NumericCode: "975", Grapheme: "\u043b\u0432", Pattern: "\u00a4#,##0.00", Status: CurrencyWithdrawn, Introduced: time.Date(1999, 7, 5, 0, 0, 0, 0, time.UTC), Withdrawn: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), ReplacedBy: EUR, Name: "Bulgarian Lev", MinorUnitName: "stotinka", Countries: NewCountryList("BG")},
+		BHD: {Decimal: ".", Thousand: ",", Code: BHD, Fraction: 3, NumericCode: "048", Grapheme: ".\u062f.\u0628", Pattern: "#,##0.000 \u00a4", Name: "Bahraini Dinar", MinorUnitName: "fils", Countries: NewCountryList("BH")},
+		BIF: {Decimal: ".", Thousand: ",", Code: BIF, Fraction: 0, NumericCode: "108", Grapheme: "Fr", Pattern: "#,##0\u00a4", Name: "Burundi Franc", Countries: NewCountryList("BI")},
+		BMD: {Decimal: ".", Thousand: ",", Code: BMD, Fraction: 2, NumericCode: "060", Grapheme: "$", Pattern: "\u00a4#,##0.00", Name: "Bermudian Dollar", MinorUnitName: "cent", Countries: NewCountryList("BM")},
+		BND: {Decimal: ".", Thousand: ",", Code: BND, Fraction: 2, NumericCode: "096", Grapheme: "$", Pattern: "\u00a4#,##0.00", Name: "Brunei Dollar", MinorUnitName: "sen", Countries: NewCountryList("BN")},
+		BOB: {Decimal: ".", Thousand: ",", Code: BOB, Fraction: 2, NumericCode: "068", Grapheme: "Bs.", Pattern: "\u00a4#,##0.00", Name: "Boliviano", MinorUnitName: "centavo", Countries: NewCountryList("BO")},
+		BRL: {Decimal: ",", Thousand: ".", Code: BRL, Fraction: 2, NumericCode: "986", Grapheme: "R$", Pattern: "\u00a4#,##0.00", Name: "Brazilian Real", MinorUnitName: "centavo", Countries: NewCountryList("BR")},
+		BSD: {Decimal: ".", Thousand: ",", Code: BSD, Fraction: 2, NumericCode: "044", Grapheme: "$", Pattern: "\u00a4#,##0.00", Name: "Bahamian Dollar", MinorUnitName: "cent", Countries: NewCountryList("BS")},
+		BTN: {Decimal: ".", Thousand: ",", Code: BTN, Fraction: 2, NumericCode: "064", Grapheme: "Nu.", Pattern: "#,##0.00\u00a4", Name: "Ngultrum", MinorUnitName: "chetrum", Countries: NewCountryList("BT")},
+		BWP: {Decimal: ".", Thousand: ",", Code: BWP, Fraction: 2, NumericCode: "072", Grapheme: "P", Pattern: "\u00a4#,##0.00", Name: "Pula", MinorUnitName: "thebe", Countries: NewCountryList("BW")},
+		BYN: {Decimal: ",", Thousand: " ", Code: BYN, Fraction: 2, NumericCode: "933", Grapheme: "p.", Pattern: "#,##0.00 \u00a4", Introduced: time.Date(2016, 7, 1, 0, 0, 0, 0, time.UTC), Name: "Belarusian Ruble", MinorUnitName: "kapeyka", Countries: NewCountryList("BY")},
+		BYR: {Decimal: ",", Thousand: " ", Code: BYR, Fraction: 0, NumericCode: "974", Grapheme: "p.", Pattern: "#,##0 \u00a4", Status: CurrencyWithdrawn, Introduced: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), Withdrawn: time.Date(2016, 7, 1, 0, 0, 0, 0, time.UTC), ReplacedBy: BYN, Name: "Belarusian Ruble", Countries: NewCountryList("BY")},
+		BZD: {Decimal: ".", Thousand: ",", Code: BZD, Fraction: 2, NumericCode: "084", Grapheme: "BZ$", Pattern: "\u00a4#,##0.00", Name: "Belize Dollar", MinorUnitName: "cent", Countries: NewCountryList("BZ")},
+		CAD: {Decimal: ".", Thousand: ",", Code: CAD, Fraction: 2, NumericCode: "124", Grapheme: "$", Pattern: "\u00a4#,##0.00", CashRounding: 5, Name: "Canadian Dollar", MinorUnitName: "cent", Countries: NewCountryList("CA")},
+		CDF: {Decimal: ".", Thousand: ",", Code: CDF, Fraction: 2, NumericCode: "976", Grapheme: "FC", Pattern: "#,##0.00\u00a4", Name: "Congolese Franc", MinorUnitName: "centime", Countries: NewCountryList("CD")},
+		CHF: {Decimal: ".", Thousand: ",", Code: CHF, Fraction: 2, NumericCode: "756", Grapheme: "CHF", Pattern: "#,##0.00 \u00a4", CashRounding: 5, Name: "Swiss Franc", MinorUnitName: "centime", Countries: NewCountryList("CH", "LI")},
+		CLF: {Decimal: ",", Thousand: ".", Code: CLF, Fraction: 4, NumericCode: "990", Grapheme: "UF", Pattern: "\u00a4#,##0.0000", Name: "Unidad de Fomento"},
+		CLP: {Decimal: ",", Thousand: ".", Code: CLP, Fraction: 0, NumericCode: "152", Grapheme: "$", Pattern: "\u00a4#,##0", Name: "Chilean Peso", Countries: NewCountryList("CL")},
+		CNY: {Decimal: ".", Thousand: ",", Code: CNY, Fraction: 2, NumericCode: "156", Grapheme: "\u5143", Pattern: "#,##0.00 \u00a4", Name: "Yuan Renminbi", MinorUnitName: "fen", Countries: NewCountryList("CN")},
+		COP: {Decimal: ",", Thousand: ".", Code: COP, Fraction: 2, NumericCode: "170", Grapheme: "$", Pattern: "\u00a4#,##0.00", Name: "Colombian Peso", MinorUnitName: "centavo", Countries: NewCountryList("CO")},
+		CRC: {Decimal: ".", Thousand: ",", Code: CRC, Fraction: 2, NumericCode: "188", Grapheme: "\u20a1", Pattern: "\u00a4#,##0.00", CashRounding: 100, Name: "Costa Rican Colon", MinorUnitName: "c\u00e9ntimo", Countries: NewCountryList("CR")},
+		CUC: {Decimal: ".", Thousand: ",", Code: CUC, Fraction: 2, NumericCode: "931", Grapheme: "$", Pattern: "#,##0.00\u00a4", Status: CurrencyWithdrawn, Introduced: time.Date(1994, 1, 1, 0, 0, 0, 0, time.UTC), Withdrawn: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), ReplacedBy: CUP, Name: "Peso Convertible", MinorUnitName: "centavo", Countries: NewCountryList("CU")},
+		CUP: {Decimal: ".", Thousand: ",", Code: CUP, Fraction: 2, NumericCode: "192", Grapheme: "$MN", Pattern: "\u00a4#,##0.00", Name: "Cuban Peso", MinorUnitName: "centavo", Countries: NewCountryList("CU")},
+		CVE: {Decimal: ".", Thousand: ",", Code: CVE, Fraction: 2, NumericCode: "132", Grapheme: "$", Pattern: "#,##0.00\u00a4", Name: "Cabo Verde Escudo", MinorUnitName: "centavo", Countries: NewCountryList("CV")},
+		CZK: {Decimal: ".", Thousand: ",", Code: CZK, Fraction: 2, NumericCode: "203", Grapheme: "K\u010d", Pattern: "#,##0.00 \u00a4", CashRounding: 100, Name: "Czech Koruna", MinorUnitName: "hal\u00e9\u0159", Countries: NewCountryList("CZ")},
+		DJF: {Decimal: ".", Thousand: ",", Code: DJF, Fraction: 0, NumericCode: "262", Grapheme: "Fdj", Pattern: "#,##0 \u00a4", Name: "Djibouti Franc", Countries: NewCountryList("DJ")},
+		DKK: {Decimal: ",", Thousand: ".", Code: DKK, Fraction: 2, NumericCode: "208", Grapheme: "kr", Pattern: "\u00a4 #,##0.00", CashRounding: 50, Name: "Danish Krone", MinorUnitName: "\u00f8re", Countries: NewCountryList("DK", "FO", "GL")},
+		DOP: {Decimal: ".", Thousand: ",", Code: DOP, Fraction: 2, NumericCode: "214", Grapheme: "RD$", Pattern: "\u00a4#,##0.00", Name: "Dominican Peso", MinorUnitName: "centavo", Countries: NewCountryList("DO")},
+		DZD: {Decimal: ".", Thousand: ",", Code: DZD, Fraction: 2, NumericCode: "012", Grapheme: ".\u062f.\u062c", Pattern: "#,##0.00 \u00a4", Name: "Algerian Dinar", MinorUnitName: "santeem", Countries: NewCountryList("DZ")},
+		EEK: {Decimal: ".", Thousand: ",", Code: EEK, Fraction: 2, NumericCode: "233", Grapheme: "kr", Pattern: "\u00a4#,##0.00", Status: CurrencyWithdrawn, Introduced: time.Date(1992, 6, 20, 0, 0, 0, 0, time.UTC), Withdrawn: time.Date(2011, 1, 1, 0, 0, 0, 0, time.UTC), ReplacedBy: EUR, Name: "Kroon", MinorUnitName: "sent", Countries: NewCountryList("EE")},
+		EGP: {Decimal: ".", Thousand: ",", Code: EGP, Fraction: 2, NumericCode: "818", Grapheme: "\u00a3", Pattern: "\u00a4#,##0.00", Name: "Egyptian Pound", MinorUnitName: "piastre", Countries: NewCountryList("EG")},
+		ERN: {Decimal: ".", Thousand: ",", Code: ERN, Fraction: 2, NumericCode: "232", Grapheme: "Nfk", Pattern: "#,##0.00 \u00a4", Name: "Nakfa", MinorUnitName: "cent", Countries: NewCountryList("ER")},
+		ETB: {Decimal: ".", Thousand: ",", Code: ETB, Fraction: 2, NumericCode: "230", Grapheme: "Br", Pattern: "#,##0.00 \u00a4", Name: "Ethiopian Birr", MinorUnitName: "santim", Countries: NewCountryList("ET")},
+		EUR: {Decimal: ".", Thousand: ",", Code: EUR, Fraction: 2, NumericCode: "978", Grapheme: "\u20ac", Pattern: "\u00a4#,##0.00", Introduced: time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC), Name: "Euro", MinorUnitName: "cent", Countries: NewCountryList("AD", "AT", "AX", "BE", "BG", "BL", "CY", "DE", "EE", "ES", "FI", "FR", "GF", "GP", "GR", "HR", "IE", "IT", "LT", "LU", "LV", "MC", "ME", "MF", "MQ", "MT", "NL", "PM", "PT", "RE", "SI", "SK", "SM", "TF", "VA", "XK", "YT")},
+		FJD: {Decimal: ".", Thousand: ",", Code: FJD, Fraction: 2, NumericCode: "242", Grapheme: "$", Pattern: "\u00a4#,##0.00", Name: "Fiji Dollar", MinorUnitName: "cent", Countries: NewCountryList("FJ")},
+		FKP: {Decimal: ".", Thousand: ",", Code: FKP, Fraction: 2, NumericCode: "238", Grapheme: "\u00a3", Pattern: "\u00a4#,##0.00", Name: "Falkland Islands Pound", MinorUnitName: "penny", Countries: NewCountryList("FK")},
+		GBP: {Decimal: ".", Thousand: ",", Code: GBP, Fraction: 2, NumericCode: "826", Grapheme: "\u00a3", Pattern: "\u00a4#,##0.00", Name: "Pound Sterling", MinorUnitName: "penny", Countries: NewCountryList("GB", "GG", "GS", "IM", "JE")},
+		GEL: {Decimal: ".", Thousand: ",", Code: GEL, Fraction: 2, NumericCode: "981", Grapheme: "\u10da", Pattern: "#,##0.00 \u00a4", Name: "Lari", MinorUnitName: "tetri", Countries: NewCountryList("GE")},
+		GGP: {Decimal: ".", Thousand: ",", Code: GGP, Fraction: 2, NumericCode: "", Grapheme: "\u00a3", Pattern: "\u00a4#,##0.00", Name: "Guernsey Pound", MinorUnitName: "penny", Countries: NewCountryList("GG")},
+		GHC: {Decimal: ".", Thousand: ",", Code: GHC, Fraction: 2, NumericCode: "288", Grapheme: "\u00a2", Pattern: "\u00a4#,##0.00", Status: CurrencyWithdrawn, Introduced: time.Date(1979, 3, 9, 0, 0, 0, 0, time.UTC), Withdrawn: time.Date(2007, 7, 1, 0, 0, 0, 0, time.UTC), ReplacedBy: GHS, Name: "Ghana Cedi", MinorUnitName: "pesewa", Countries: NewCountryList("GH")},
+		GHS: {Decimal: ".", Thousand: ",", Code: GHS, Fraction: 2, NumericCode: "936", Grapheme: "\u20b5", Pattern: "\u00a4#,##0.00", Introduced: time.Date(2007, 7, 1, 0, 0, 0, 0, time.UTC), Name: "Ghana Cedi", MinorUnitName: "pesewa", Countries: NewCountryList("GH")},
+		GIP: {Decimal: ".", Thousand: ",", Code: GIP, Fraction: 2, NumericCode: "292", Grapheme: "\u00a3", Pattern: "\u00a4#,##0.00", Name: "Gibraltar Pound", MinorUnitName: "penny", Countries: NewCountryList("GI")},
+		GMD: {Decimal: ".", Thousand: ",", Code: GMD, Fraction: 2, NumericCode: "270", Grapheme: "D", Pattern: "#,##0.00 \u00a4", Name: "Dalasi", MinorUnitName: "butut", Countries: NewCountryList("GM")},
+		GNF: {Decimal: ".", Thousand: ",", Code: GNF, Fraction: 0, NumericCode: "324", Grapheme: "FG", Pattern: "#,##0 \u00a4", Name: "Guinean Franc", Countries: NewCountryList("GN")},
+		GTQ: {Decimal: ".", Thousand: ",", Code: GTQ, Fraction: 2, NumericCode: "320", Grapheme: "Q", Pattern: "\u00a4#,##0.00", Name: "Quetzal", MinorUnitName: "centavo", Countries: NewCountryList("GT")},
+		GYD: {Decimal: ".", Thousand: ",", Code: GYD, Fraction: 2, NumericCode: "328", Grapheme: "$", Pattern: "\u00a4#,##0.00", Name: "Guyana Dollar", MinorUnitName: "cent", Countries: NewCountryList("GY")},
+		HKD: {Decimal: ".", Thousand: ",", Code: HKD, Fraction: 2, NumericCode: "344", Grapheme: "$", Pattern: "\u00a4#,##0.00", Name: "Hong Kong Dollar", MinorUnitName: "cent", Countries: NewCountryList("HK")},
+		HNL: {Decimal: ".", Thousand: ",", Code: HNL, Fraction: 2, NumericCode: "340", Grapheme: "L", Pattern: "\u00a4#,##0.00", Name: "Lempira", MinorUnitName: "centavo", Countries: NewCountryList("HN")},
+		HRK: {Decimal: ",", Thousand: ".", Code: HRK, Fraction: 2, NumericCode: "191", Grapheme: "kn", Pattern: "#,##0.00 \u00a4", Status: CurrencyWithdrawn, Introduced: time.Date(1994, 5, 30, 0, 0, 0, 0, time.UTC), Withdrawn: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), ReplacedBy: EUR, Name: "Kuna", MinorUnitName: "lipa", Countries: NewCountryList("HR")},
+		HTG: {Decimal: ",", Thousand: ".", Code: HTG, Fraction: 2, NumericCode: "332", Grapheme: "G", Pattern: "#,##0.00 \u00a4", Name: "Gourde", MinorUnitName: "centime", Countries: NewCountryList("HT")},
+		HUF: {Decimal: ",", Thousand: ".", Code: HUF, Fraction: 0, NumericCode: "348", Grapheme: "Ft", Pattern: "#,##0 \u00a4", CashRounding: 5, Name: "Forint", Countries: NewCountryList("HU")},
+		IDR: {Decimal: ".", Thousand: ",", Code: IDR, Fraction: 2, NumericCode: "360", Grapheme: "Rp", Pattern: "\u00a4#,##0.00", CashRounding: 100, Name: "Rupiah", MinorUnitName: "sen", Countries: NewCountryList("ID")},
+		ILS: {Decimal: ".", Thousand: ",", Code: ILS, Fraction: 2, NumericCode: "376", Grapheme: "\u20aa", Pattern: "\u00a4#,##0.00", Name: "New Israeli Sheqel", MinorUnitName: "agora", Countries: NewCountryList("IL", "PS")},
+		IMP: {Decimal: ".", Thousand: ",", Code: IMP, Fraction: 2, NumericCode: "", Grapheme: "\u00a3", Pattern: "\u00a4#,##0.00", Name: "Manx Pound", MinorUnitName: "penny", Countries: NewCountryList("IM")},
+		INR: {Decimal: ".", Thousand: ",", Code: INR, Fraction: 2, NumericCode: "356", Grapheme: "\u20b9", Pattern: "\u00a4#,##0.00", Name: "Indian Rupee", MinorUnitName: "paisa", Countries: NewCountryList("BT", "IN")},
+		IQD: {Decimal: ".", Thousand: ",", Code: IQD, Fraction: 3, NumericCode: "368", Grapheme: ".\u062f.\u0639", Pattern: "#,##0.000 \u00a4", Name: "Iraqi Dinar", MinorUnitName: "fils", Countries: NewCountryList("IQ")},
+		IRR: {Decimal: ".", Thousand: ",", Code: IRR, Fraction: 2, NumericCode: "364", Grapheme: "\ufdfc", Pattern: "#,##0.00 \u00a4", Name: "Iranian Rial", MinorUnitName: "dinar", Countries: NewCountryList("IR")},
+		ISK: {Decimal: ",", Thousand: ".", Code: ISK, Fraction: 0, NumericCode: "352", Grapheme: "kr", Pattern: "\u00a4#,##0", Name: "Iceland Krona", Countries: NewCountryList("IS")},
+		JEP: {Decimal: ".", Thousand: ",", Code: JEP, Fraction: 2, NumericCode: "", Grapheme: "\u00a3", Pattern: "\u00a4#,##0.00", Name: "Jersey Pound", MinorUnitName: "penny", Countries: NewCountryList("JE")},
+		JMD: {Decimal: ".", Thousand: ",", Code: JMD, Fraction: 2, NumericCode: "388", Grapheme: "J$", Pattern: "\u00a4#,##0.00", Name: "Jamaican Dollar", MinorUnitName: "cent", Countries: NewCountryList("JM")},
+		JOD: {Decimal: ".", Thousand: ",", Code: JOD, Fraction: 3, NumericCode: "400", Grapheme: ".\u062f.\u0625", Pattern: "#,##0.000 \u00a4", Name: "Jordanian Dinar", MinorUnitName: "fils", Countries: NewCountryList("JO")},
+		JPY: {Decimal: ".", Thousand: ",", Code: JPY, Fraction: 0, NumericCode: "392", Grapheme: "\u00a5", Pattern: "\u00a4#,##0", Name: "Yen", Countries: NewCountryList("JP")},
+		KES: {Decimal: ".", Thousand: ",", Code: KES, Fraction: 2, NumericCode: "404", Grapheme: "KSh", Pattern: "\u00a4#,##0.00", Name: "Kenyan Shilling", MinorUnitName: "cent", Countries: NewCountryList("KE")},
+		KGS: {Decimal: ".", Thousand: ",", Code: KGS, Fraction: 2, NumericCode: "417", Grapheme: "\u0441\u043e\u043c", Pattern: "\u00a4#,##0.00", Name: "Som", MinorUnitName: "tyiyn", Countries: NewCountryList("KG")},
+		KHR: {Decimal: ".", Thousand: ",", Code: KHR, Fraction: 2, NumericCode: "116", Grapheme: "\u17db", Pattern: "\u00a4#,##0.00", Name: "Riel", MinorUnitName: "sen", Countries: NewCountryList("KH")},
+		KMF: {Decimal: ".", Thousand: ",", Code: KMF, Fraction: 0, NumericCode: "174", Grapheme: "CF", Pattern: "\u00a4#,##0", Name: "Comorian Franc", Countries: NewCountryList("KM")},
+		KPW: {Decimal: ".", Thousand: ",", Code: KPW, Fraction: 0, NumericCode: "408", Grapheme: "\u20a9", Pattern: "\u00a4#,##0", Name: "North Korean Won", Countries: NewCountryList("KP")},
+		KRW: {Decimal: ".", Thousand: ",", Code: KRW, Fraction: 0, NumericCode: "410", Grapheme: "\u20a9", Pattern: "\u00a4#,##0", Name: "Won", Countries: NewCountryList("KR")},
+		KWD: {Decimal: ".", Thousand: ",", Code: KWD, Fraction: 3, NumericCode: "414", Grapheme: ".\u062f.\u0643", Pattern: "#,##0.000 \u00a4", Name: "Kuwaiti Dinar", MinorUnitName: "fils", Countries: NewCountryList("KW")},
+		KYD: {Decimal: ".", Thousand: ",", Code: KYD, Fraction: 2, NumericCode: "136", Grapheme: "$", Pattern: "\u00a4#,##0.00", Name: "Cayman Islands Dollar", MinorUnitName: "cent", Countries: NewCountryList("KY")},
+		KZT: {Decimal: ".", Thousand: ",", Code: KZT, Fraction: 2, NumericCode: "398", Grapheme: "\u20b8", Pattern: "\u00a4#,##0.00", Name: "Tenge", MinorUnitName: "tiyn", Countries: NewCountryList("KZ")},
+		LAK: {Decimal: ".", Thousand: ",", Code: LAK, Fraction: 2, NumericCode: "418", Grapheme: "\u20ad", Pattern: "\u00a4#,##0.00", Name: "Lao Kip", MinorUnitName: "att", Countries: NewCountryList("LA")},
+		LBP: {Decimal: ".", Thousand: ",", Code: LBP, Fraction: 2, NumericCode: "422", Grapheme: "\u00a3", Pattern: "\u00a4#,##0.00", Name: "Lebanese Pound", MinorUnitName: "piastre", Countries: NewCountryList("LB")},
+		LKR: {Decimal: ".", Thousand: ",", Code: LKR, Fraction: 2, NumericCode: "144", Grapheme: "\u20a8", Pattern: "\u00a4#,##0.00", Name: "Sri Lanka Rupee", MinorUnitName: "cent", Countries: NewCountryList("LK")},
+		LRD: {Decimal: ".", Thousand: ",", Code: LRD, Fraction: 2, NumericCode: "430", Grapheme: "$", Pattern: "\u00a4#,##0.00", Name: "Liberian Dollar", MinorUnitName: "cent", Countries: NewCountryList("LR")},
+		LSL: {Decimal: ".", Thousand: ",", Code: LSL, Fraction: 2, NumericCode: "426", Grapheme: "L", Pattern: "\u00a4#,##0.00", Name: "Loti", MinorUnitName: "sente", Countries: NewCountryList("LS")},
+		LTL: {Decimal: ".", Thousand: ",", Code: LTL, Fraction: 2, NumericCode: "440", Grapheme: "Lt", Pattern: "\u00a4#,##0.00", Status: CurrencyWithdrawn, Introduced: time.Date(1993, 6, 25, 0, 0, 0, 0, time.UTC), Withdrawn: time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC), ReplacedBy: EUR, Name: "Lithuanian Litas", MinorUnitName: "centas", Countries: NewCountryList("LT")},
+		LVL: {Decimal: ".", Thousand: ",", Code: LVL, Fraction: 2, NumericCode: "428", Grapheme: "Ls", Pattern: "#,##0.00 \u00a4", Status: CurrencyWithdrawn, Introduced: time.Date(1993, 3, 5, 0, 0, 0, 0, time.UTC), Withdrawn: time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC), ReplacedBy: EUR, Name: "Latvian Lats", MinorUnitName: "sant\u012bms", Countries: NewCountryList("LV")},
+		LYD: {Decimal: ".", Thousand: ",", Code: LYD, Fraction: 3, NumericCode: "434", Grapheme: ".\u062f.\u0644", Pattern: "#,##0.000 \u00a4", Name: "Libyan Dinar", MinorUnitName: "dirham", Countries: NewCountryList("LY")},
+		MAD: {Decimal: ".", Thousand: ",", Code: MAD, Fraction: 2, NumericCode: "504", Grapheme: ".\u062f.\u0645", Pattern: "#,##0.00 \u00a4", Name: "Moroccan Dirham", MinorUnitName: "centime", Countries: NewCountryList("EH", "MA")},
+		MDL: {Decimal: ".", Thousand: ",", Code: MDL, Fraction: 2, NumericCode: "498", Grapheme: "lei", Pattern: "#,##0.00 \u00a4", Name: "Moldovan Leu", MinorUnitName: "ban", Countries: NewCountryList("MD")},
+		MKD: {Decimal: ".", Thousand: ",", Code: MKD, Fraction: 2, NumericCode: "807", Grapheme: "\u0434\u0435\u043d", Pattern: "\u00a4#,##0.00", Name: "Denar", MinorUnitName: "deni", Countries: NewCountryList("MK")},
+		MMK: {Decimal: ".", Thousand: ",", Code: MMK, Fraction: 2, NumericCode: "104", Grapheme: "K", Pattern: "\u00a4#,##0.00", Name: "Kyat", MinorUnitName: "pya", Countries: NewCountryList("MM")},
+		MNT: {Decimal: ".", Thousand: ",", Code: MNT, Fraction: 2, NumericCode: "496", Grapheme: "\u20ae", Pattern: "\u00a4#,##0.00", Name: "Tugrik", MinorUnitName: "m\u00f6ng\u00f6", Countries: NewCountryList("MN")},
+		MOP: {Decimal: ".", Thousand: ",", Code: MOP, Fraction: 2, NumericCode: "446", Grapheme: "P", Pattern: "#,##0.00 \u00a4", Name: "Pataca", MinorUnitName: "avo", Countries: NewCountryList("MO")},
+		MRU: {Decimal: ".", Thousand: ",", Code: MRU, Fraction: 2, NumericCode: "929", Grapheme: "UM", Pattern: "#,##0.00 \u00a4", Introduced: time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC), Name: "Ouguiya", MinorUnitName: "khoums", Countries: NewCountryList("MR")},
+		MUR: {Decimal: ".", Thousand: ",", Code: MUR, Fraction: 2, NumericCode: "480", Grapheme: "\u20a8", Pattern: "\u00a4#,##0.00", Name: "Mauritius Rupee", MinorUnitName: "cent", Countries: NewCountryList("MU")},
+		MVR: {Decimal: ".", Thousand: ",", Code: MVR, Fraction: 2, NumericCode: "462", Grapheme: "MVR", Pattern: "#,##0.00 \u00a4", Name: "Rufiyaa", MinorUnitName: "laari", Countries: NewCountryList("MV")},
+		MWK: {Decimal: ".", Thousand: ",", Code: MWK, Fraction: 2, NumericCode: "454", Grapheme: "MK", Pattern: "\u00a4#,##0.00", Name: "Malawi Kwacha", MinorUnitName: "tambala", Countries: NewCountryList("MW")},
+		MXN: {Decimal: ".", Thousand: ",", Code: MXN, Fraction: 2, NumericCode: "484", Grapheme: "$", Pattern: "\u00a4#,##0.00", Name: "Mexican Peso", MinorUnitName: "centavo", Countries: NewCountryList("MX")},
+		MYR: {Decimal: ".", Thousand: ",", Code: MYR, Fraction: 2, NumericCode: "458", Grapheme: "RM", Pattern: "\u00a4#,##0.00", Name: "Malaysian Ringgit", MinorUnitName: "sen", Countries: NewCountryList("MY")},
+		MZN: {Decimal: ".", Thousand: ",", Code: MZN, Fraction: 2, NumericCode: "943", Grapheme: "MT", Pattern: "\u00a4#,##0.00", Name: "Mozambique Metical", MinorUnitName: "centavo", Countries: NewCountryList("MZ")},
+		NAD: {Decimal: ".", Thousand: ",", Code: NAD, Fraction: 2, NumericCode: "516", Grapheme: "$", Pattern: "\u00a4#,##0.00", Name: "Namibia Dollar", MinorUnitName: "cent", Countries: NewCountryList("NA")},
+		NGN: {Decimal: ".", Thousand: ",", Code: NGN, Fraction: 2, NumericCode: "566", Grapheme: "\u20a6", Pattern: "\u00a4#,##0.00", Name: "Naira", MinorUnitName: "kobo", Countries: NewCountryList("NG")},
+		NIO: {Decimal: ".", Thousand: ",", Code: NIO, Fraction: 2, NumericCode: "558", Grapheme: "C$", Pattern: "\u00a4#,##0.00", Name: "Cordoba Oro", MinorUnitName: "centavo", Countries: NewCountryList("NI")},
+		NOK: {Decimal: ".", Thousand: ",", Code: NOK, Fraction: 2, NumericCode: "578", Grapheme: "kr", Pattern: "#,##0.00 \u00a4", CashRounding: 100, Name: "Norwegian Krone", MinorUnitName: "\u00f8re", Countries: NewCountryList("BV", "NO", "SJ")},
+		NPR: {Decimal: ".", Thousand: ",", Code: NPR, Fraction: 2, NumericCode: "524", Grapheme: "\u20a8", Pattern: "\u00a4#,##0.00", Name: "Nepalese Rupee", MinorUnitName: "paisa", Countries: NewCountryList("NP")},
+		NZD: {Decimal: ".", Thousand: ",", Code: NZD, Fraction: 2, NumericCode: "554", Grapheme: "$", Pattern: "\u00a4#,##0.00", CashRounding: 10, Name: "New Zealand Dollar", MinorUnitName: "cent", Countries: NewCountryList("CK", "NU", "NZ", "PN", "TK")},
+		OMR: {Decimal: ".", Thousand: ",", Code: OMR, Fraction: 3, NumericCode: "512", Grapheme: "\ufdfc", Pattern: "#,##0.000 \u00a4", Name: "Rial Omani", MinorUnitName: "baisa", Countries: NewCountryList("OM")},
+		PAB: {Decimal: ".", Thousand: ",", Code: PAB, Fraction: 2, NumericCode: "590", Grapheme: "B/.", Pattern: "\u00a4#,##0.00", Name: "Balboa", MinorUnitName: "cent\u00e9simo", Countries: NewCountryList("PA")},
+		PEN: {Decimal: ".", Thousand: ",", Code: PEN, Fraction: 2, NumericCode: "604", Grapheme: "S/", Pattern: "\u00a4#,##0.00", Name: "Sol", MinorUnitName: "c\u00e9ntimo", Countries: NewCountryList("PE")},
+		PGK: {Decimal: ".", Thousand: ",", Code: PGK, Fraction: 2, NumericCode: "598", Grapheme: "K", Pattern: "#,##0.00 \u00a4", Name: "Kina", MinorUnitName: "toea", Countries: NewCountryList("PG")},
+		PHP: {Decimal: ".", Thousand: ",", Code: PHP, Fraction: 2, NumericCode: "608", Grapheme: "\u20b1", Pattern: "\u00a4#,##0.00", Name: "Philippine Peso", MinorUnitName: "sentimo", Countries: NewCountryList("PH")},
+		PKR: {Decimal: ".", Thousand: ",", Code: PKR, Fraction: 2, NumericCode: "586", Grapheme: "\u20a8", Pattern: "\u00a4#,##0.00", CashRounding: 100, Name: "Pakistan Rupee", MinorUnitName: "paisa", Countries: NewCountryList("PK")},
+		PLN: {Decimal: ".", Thousand: ",", Code: PLN, Fraction: 2, NumericCode: "985", Grapheme: "z\u0142", Pattern: "#,##0.00 \u00a4", Name: "Polish Z\u0142oty", MinorUnitName: "grosz", Countries: NewCountryList("PL")},
+		PYG: {Decimal: ".", Thousand: ",", Code: PYG, Fraction: 0, NumericCode: "600", Grapheme: "Gs", Pattern: "#,##0\u00a4", Name: "Guarani", Countries: NewCountryList("PY")},
+		QAR: {Decimal: ".", Thousand: ",", Code: QAR, Fraction: 2, NumericCode: "634", Grapheme: "\ufdfc", Pattern: "#,##0.00 \u00a4", Name: "Qatari Rial", MinorUnitName: "dirham", Countries: NewCountryList("QA")},
+		RON: {Decimal: ".", Thousand: ",", Code: RON, Fraction: 2, NumericCode: "946", Grapheme: "lei", Pattern: "\u00a4#,##0.00", Name: "Romanian Leu", MinorUnitName: "ban", Countries: NewCountryList("RO")},
+		RSD: {Decimal: ".", Thousand: ",", Code: RSD, Fraction: 2, NumericCode: "941", Grapheme: "\u0414\u0438\u043d.", Pattern: "\u00a4#,##0.00", Name: "Serbian Dinar", MinorUnitName: "para", Countries: NewCountryList("RS")},
+		RUB: {Decimal: ".", Thousand: ",", Code: RUB, Fraction: 2, NumericCode: "643", Grapheme: "\u20bd", Pattern: "#,##0.00 \u00a4", Introduced: time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC), Name: "Russian Ruble", MinorUnitName: "kopeck", Countries: NewCountryList("RU")},
+		RUR: {Decimal: ".", Thousand: ",", Code: RUR, Fraction: 2, NumericCode: "810", Grapheme: "\u20bd", Pattern: "#,##0.00 \u00a4", Status: CurrencyWithdrawn, Introduced: time.Date(1991, 12, 25, 0, 0, 0, 0, time.UTC), Withdrawn: time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC), ReplacedBy: RUB, Name: "Russian Ruble", MinorUnitName: "kopeck", Countries: NewCountryList("RU")},
+		RWF: {Decimal: ".", Thousand: ",", Code: RWF, Fraction: 0, NumericCode: "646", Grapheme: "FRw", Pattern: "#,##0 \u00a4", Name: "Rwanda Franc", Countries: NewCountryList("RW")},
+		SAR: {Decimal: ".", Thousand: ",", Code: SAR, Fraction: 2, NumericCode: "682", Grapheme: "\ufdfc", Pattern: "#,##0.00 \u00a4", Name: "Saudi Riyal", MinorUnitName: "halala", Countries: NewCountryList("SA")},
+		SBD: {Decimal: ".", Thousand: ",", Code: SBD, Fraction: 2, NumericCode: "090", Grapheme: "$", Pattern: "\u00a4#,##0.00", Name: "Solomon Islands Dollar", MinorUnitName: "cent", Countries: NewCountryList("SB")},
+		SCR: {Decimal: ".", Thousand: ",", Code: SCR, Fraction: 2, NumericCode: "690", Grapheme: "\u20a8", Pattern: "\u00a4#,##0.00", Name: "Seychelles Rupee", MinorUnitName: "cent", Countries: NewCountryList("SC")},
+		SDG: {Decimal: ".", Thousand: ",", Code: SDG, Fraction: 2, NumericCode: "938", Grapheme: "\u00a3", Pattern: "\u00a4#,##0.00", Name: "Sudanese Pound", MinorUnitName: "piastre", Countries: NewCountryList("SD")},
+		SEK: {Decimal: ".", Thousand: ",", Code: SEK, Fraction: 2, NumericCode: "752", Grapheme: "kr", Pattern: "#,##0.00 \u00a4", CashRounding: 100, Name: "Swedish Krona", MinorUnitName: "\u00f6re", Countries: NewCountryList("SE")},
+		SGD: {Decimal: ".", Thousand: ",", Code: SGD, Fraction: 2, NumericCode: "702", Grapheme: "$", Pattern: "\u00a4#,##0.00", Name: "Singapore Dollar", MinorUnitName: "cent", Countries: NewCountryList("SG")},
+		SHP: {Decimal: ".", Thousand: ",", Code: SHP, Fraction: 2, NumericCode: "654", Grapheme: "\u00a3", Pattern: "\u00a4#,##0.00", Name: "Saint Helena Pound", MinorUnitName: "penny", Countries: NewCountryList("SH")},
+		SKK: {Decimal: ".", Thousand: ",", Code: SKK, Fraction: 2, NumericCode: "703", Grapheme: "Sk", Pattern: "\u00a4#,##0.00", Status: CurrencyWithdrawn, Introduced: time.Date(1993, 1, 8, 0, 0, 0, 0, time.UTC), Withdrawn: time.Date(2009, 1, 1, 0, 0, 0, 0, time.UTC), ReplacedBy: EUR, Name: "Slovak Koruna", MinorUnitName: "halier", Countries: NewCountryList("SK")},
+		SLE: {Decimal: ".", Thousand: ",", Code: SLE, Fraction: 2, NumericCode: "925", Grapheme: "Le", Pattern: "#,##0.00 \u00a4", Introduced: time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC), Name: "Leone", MinorUnitName: "cent", Countries: NewCountryList("SL")},
+		SLL: {Decimal: ".", Thousand: ",", Code: SLL, Fraction: 2, NumericCode: "694", Grapheme: "Le", Pattern: "#,##0.00 \u00a4", Status: CurrencyWithdrawn, Introduced: time.Date(1964, 8, 4, 0, 0, 0, 0, time.UTC), Withdrawn: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), ReplacedBy: SLE, Name: "Leone", MinorUnitName: "cent", Countries: NewCountryList("SL")},
+		SOS: {Decimal: ".", Thousand: ",", Code: SOS, Fraction: 2, NumericCode: "706", Grapheme: "Sh", Pattern: "#,##0.00 \u00a4", Name: "Somali Shilling", MinorUnitName: "cent", Countries: NewCountryList("SO")},
+		SRD: {Decimal: ".", Thousand: ",", Code: SRD, Fraction: 2, NumericCode: "968", Grapheme: "$", Pattern: "\u00a4#,##0.00", Name: "Surinam Dollar", MinorUnitName: "cent", Countries: NewCountryList("SR")},
+		SSP: {Decimal: ".", Thousand: ",", Code: SSP, Fraction: 2, NumericCode: "728", Grapheme: "\u00a3", Pattern: "#,##0.00 \u00a4", Name: "South Sudanese Pound", MinorUnitName: "piastre", Countries: NewCountryList("SS")},
+		STD: {Decimal: ".", Thousand: ",", Code: STD, Fraction: 2, NumericCode: "678", Grapheme: "Db", Pattern: "#,##0.00 \u00a4", Status: CurrencyWithdrawn, Introduced: time.Date(1977, 1, 1, 0, 0, 0, 0, time.UTC), Withdrawn: time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC), ReplacedBy: STN, Name: "Dobra", MinorUnitName: "c\u00eantimo", Countries: NewCountryList("ST")},
+		STN: {Decimal: ".", Thousand: ",", Code: STN, Fraction: 2, NumericCode: "930", Grapheme: "Db", Pattern: "#,##0.00 \u00a4", Introduced: time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC), Name: "Dobra", MinorUnitName: "c\u00eantimo", Countries: NewCountryList("ST")},
+		SVC: {Decimal: ".", Thousand: ",", Code: SVC, Fraction: 2, NumericCode: "222", Grapheme: "\u20a1", Pattern: "\u00a4#,##0.00", Name: "El Salvador Colon", MinorUnitName: "centavo"},
+		SYP: {Decimal: ".", Thousand: ",", Code: SYP, Fraction: 2, NumericCode: "760", Grapheme: "\u00a3", Pattern: "#,##0.00 \u00a4", Name: "Syrian Pound", MinorUnitName: "piastre", Countries: NewCountryList("SY")},
+		SZL: {Decimal: ".", Thousand: ",", Code: SZL, Fraction: 2, NumericCode: "748", Grapheme: "\u00a3", Pattern: "\u00a4#,##0.00", Name: "Lilangeni", MinorUnitName: "cent", Countries: NewCountryList("SZ")},
+		THB: {Decimal: ".", Thousand: ",", Code: THB, Fraction: 2, NumericCode: "764", Grapheme: "\u0e3f", Pattern: "\u00a4#,##0.00", Name: "Baht", MinorUnitName: "satang", Countries: NewCountryList("TH")},
+		TJS: {Decimal: ".", Thousand: ",", Code: TJS, Fraction: 2, NumericCode: "972", Grapheme: "SM", Pattern: "#,##0.00 \u00a4", Name: "Somoni", MinorUnitName: "diram", Countries: NewCountryList("TJ")},
+		TMT: {Decimal: ".", Thousand: ",", Code: TMT, Fraction: 2, NumericCode: "934", Grapheme: "T", Pattern: "#,##0.00 \u00a4", Name: "Turkmenistan New Manat", MinorUnitName: "tenge", Countries: NewCountryList("TM")},
+		TND: {Decimal: ".", Thousand: ",", Code: TND, Fraction: 3, NumericCode: "788", Grapheme: ".\u062f.\u062a", Pattern: "#,##0.000 \u00a4", Name: "Tunisian Dinar", MinorUnitName: "millime", Countries: NewCountryList("TN")},
+		TOP: {Decimal: ".", Thousand: ",", Code: TOP, Fraction: 2, NumericCode: "776", Grapheme: "T$", Pattern: "\u00a4#,##0.00", Name: "Pa\u2019anga", MinorUnitName: "seniti", Countries: NewCountryList("TO")},
+		TRL: {Decimal: ".", Thousand: ",", Code: TRL, Fraction: 2, NumericCode: "792", Grapheme: "\u20a4", Pattern: "\u00a4#,##0.00", Status: CurrencyWithdrawn, Introduced: time.Date(1922, 11, 1, 0, 0, 0, 0, time.UTC), Withdrawn: time.Date(2005, 1, 1, 0, 0, 0, 0, time.UTC), ReplacedBy: TRY, Name: "Turkish Lira", MinorUnitName: "kuru\u015f", Countries: NewCountryList("TR")},
+		TRY: {Decimal: ".", Thousand: ",", Code: TRY, Fraction: 2, NumericCode: "949", Grapheme: "\u20ba", Pattern: "\u00a4#,##0.00", Introduced: time.Date(2005, 1, 1, 0, 0, 0, 0, time.UTC), Name: "Turkish Lira", MinorUnitName: "kuru\u015f", Countries: NewCountryList("TR")},
+		TTD: {Decimal: ".", Thousand: ",", Code: TTD, Fraction: 2, NumericCode: "780", Grapheme: "TT$", Pattern: "\u00a4#,##0.00", Name: "Trinidad and Tobago Dollar", MinorUnitName: "cent", Countries: NewCountryList("TT")},
+		TWD: {Decimal: ".", Thousand: ",", Code: TWD, Fraction: 2, NumericCode: "901", Grapheme: "NT$", Pattern: "\u00a4#,##0.00", CashRounding: 100, Name: "New Taiwan Dollar", MinorUnitName: "cent", Countries: NewCountryList("TW")},
+		TZS: {Decimal: ".", Thousand: ",", Code: TZS, Fraction: 0, NumericCode: "834", Grapheme: "TSh", Pattern: "\u00a4#,##0", Name: "Tanzanian Shilling", Countries: NewCountryList("TZ")},
+		UAH: {Decimal: ".", Thousand: ",", Code: UAH, Fraction: 2, NumericCode: "980", Grapheme: "\u20b4", Pattern: "#,##0.00 \u00a4", Name: "Hryvnia", MinorUnitName: "kopiyka", Countries: NewCountryList("UA")},
+		UGX: {Decimal: ".", Thousand: ",", Code: UGX, Fraction: 0, NumericCode: "800", Grapheme: "USh", Pattern: "#,##0 \u00a4", Name: "Uganda Shilling", Countries: NewCountryList("UG")},
+		USD: {Decimal: ".", Thousand: ",", Code: USD, Fraction: 2, NumericCode: "840", Grapheme: "$", Pattern: "\u00a4#,##0.00", Name: "US Dollar", MinorUnitName: "cent", Countries: NewCountryList("AS", "BQ", "EC", "FM", "GU", "IO", "MH", "MP", "PA", "PR", "PW", "SV", "TC", "TL", "UM", "US", "VG", "VI")},
+		UYU: {Decimal: ".", Thousand: ",", Code: UYU, Fraction: 2, NumericCode: "858", Grapheme: "$U", Pattern: "\u00a4#,##0.00", Name: "Peso Uruguayo", MinorUnitName: "cent\u00e9simo", Countries: NewCountryList("UY")},
+		UZS: {Decimal: ".", Thousand: ",", Code: UZS, Fraction: 2, NumericCode: "860", Grapheme: "so\u2019m", Pattern: "\u00a4#,##0.00", Name: "Uzbekistan Sum", MinorUnitName: "tiyin", Countries: NewCountryList("UZ")},
+		VEF: {Decimal: ".", Thousand: ",", Code: VEF, Fraction: 2, NumericCode: "937", Grapheme: "Bs", Pattern: "\u00a4#,##0.00", Status: CurrencyWithdrawn, Introduced: time.Date(2008, 1, 1, 0, 0, 0, 0, time.UTC), Withdrawn: time.Date(2018, 8, 20, 0, 0, 0, 0, time.UTC), ReplacedBy: VES, Name: "Bol\u00edvar", MinorUnitName: "c\u00e9ntimo", Countries: NewCountryList("VE")},
+		VES: {Decimal: ",", Thousand: ".", Code: VES, Fraction: 2, NumericCode: "928", Grapheme: "Bs.S", Pattern: "\u00a4#,##0.00", Introduced: time.Date(2018, 8, 20, 0, 0, 0, 0, time.UTC), Name: "Bol\u00edvar Soberano", MinorUnitName: "c\u00e9ntimo", Countries: NewCountryList("VE")},
+		VND: {Decimal: ".", Thousand: ",", Code: VND, Fraction: 0, NumericCode: "704", Grapheme: "\u20ab", Pattern: "#,##0 \u00a4", Name: "Dong", Countries: NewCountryList("VN")},
+		VUV: {Decimal: ".", Thousand: ",", Code: VUV, Fraction: 0, NumericCode: "548", Grapheme: "Vt", Pattern: "\u00a4#,##0", Name: "Vatu", Countries: NewCountryList("VU")},
+		WST: {Decimal: ".", Thousand: ",", Code: WST, Fraction: 2, NumericCode: "882", Grapheme: "T", Pattern: "#,##0.00 \u00a4", Name: "Tala", MinorUnitName: "sene", Countries: NewCountryList("WS")},
+		XAF: {Decimal: ".", Thousand: ",", Code: XAF, Fraction: 0, NumericCode: "950", Grapheme: "Fr", Pattern: "#,##0 \u00a4", Name: "CFA Franc BEAC", Countries: NewCountryList("CF", "CG", "CM", "GA", "GQ", "TD")},
+		XAG: {Decimal: ".", Thousand: ",", Code: XAG, Fraction: 0, NumericCode: "961", Grapheme: "oz t", Pattern: "#,##0 \u00a4", Name: "Silver"},
+		XAU: {Decimal: ".", Thousand: ",", Code: XAU, Fraction: 0, NumericCode: "959", Grapheme: "oz t", Pattern: "#,##0 \u00a4", Name: "Gold"},
+		XCD: {Decimal: ".", Thousand: ",", Code: XCD, Fraction: 2, NumericCode: "951", Grapheme: "$", Pattern: "\u00a4#,##0.00", Name: "East Caribbean Dollar", MinorUnitName: "cent", Countries: NewCountryList("AG", "AI", "DM", "GD", "KN", "LC", "MS", "VC")},
+		XDR: {Decimal: ".", Thousand: ",", Code: XDR, Fraction: 0, NumericCode: "960", Grapheme: "SDR", Pattern: "#,##0 \u00a4", Name: "SDR (Special Drawing Right)"},
+		XPF: {Decimal: ".", Thousand: ",", Code: XPF, Fraction: 0, NumericCode: "953", Grapheme: "\u20a3", Pattern: "#,##0 \u00a4", Name: "CFP Franc", Countries: NewCountryList("NC", "PF", "WF")},
+		YER: {Decimal: ".", Thousand: ",", Code: YER, Fraction: 2, NumericCode: "886", Grapheme: "\ufdfc", Pattern: "#,##0.00 \u00a4", Name: "Yemeni Rial", MinorUnitName: "fils", Countries: NewCountryList("YE")},
+		ZAR: {Decimal: ".", Thousand: ",", Code: ZAR, Fraction: 2, NumericCode: "710", Grapheme: "R", Pattern: "\u00a4#,##0.00", CashRounding: 10, Name: "Rand", MinorUnitName: "cent", Countries: NewCountryList("LS", "NA", "ZA")},
+		ZMW: {Decimal: ".", Thousand: ",", Code: ZMW, Fraction: 2, NumericCode: "967", Grapheme: "ZK", Pattern: "\u00a4#,##0.00", Name: "Zambian Kwacha", MinorUnitName: "ngwee", Countries: NewCountryList("ZM")},
+		ZWD: {Decimal: ".", Thousand: ",", Code: ZWD, Fraction: 2, NumericCode: "716", Grapheme: "Z$", Pattern: "\u00a4#,##0.00", Status: CurrencyWithdrawn, Introduced: time.Date(1980, 4, 18, 0, 0, 0, 0, time.UTC), Withdrawn: time.Date(2009, 2, 2, 0, 0, 0, 0, time.UTC), ReplacedBy: ZWL, Name: "Zimbabwe Dollar", MinorUnitName: "cent", Countries: NewCountryList("ZW")},
+		ZWG: {Decimal: ".", Thousand: ",", Code: ZWG, Fraction: 2, NumericCode: "924", Grapheme: "ZiG", Pattern: "\u00a4#,##0.00", Introduced: time.Date(2024, 6, 25, 0, 0, 0, 0, time.UTC), Name: "Zimbabwe Gold", MinorUnitName: "cent", Countries: NewCountryList("ZW")},
+		ZWL: {Decimal: ".", Thousand: ",", Code: ZWL, Fraction: 2, NumericCode: "932", Grapheme: "Z$", Pattern: "\u00a4#,##0.00", Status: CurrencyWithdrawn, Introduced: time.Date(2009, 2, 2, 0, 0, 0, 0, time.UTC), Withdrawn: time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC), ReplacedBy: ZWG, Name: "Zimbabwe Dollar", MinorUnitName: "cent", Countries: NewCountryList("ZW")},
+	}
+}
+
+const (
+	AED CurrencyCode = "AED"
+	AFN CurrencyCode = "AFN"
+	ALL CurrencyCode = "ALL"
+	AMD CurrencyCode = "AMD"
+	ANG CurrencyCode = "ANG"
+	AOA CurrencyCode = "AOA"
+	ARS CurrencyCode = "ARS"
+	AUD CurrencyCode = "AUD"
+	AWG CurrencyCode = "AWG"
+	AZN CurrencyCode = "AZN"
+	BAM CurrencyCode = "BAM"
+	BBD CurrencyCode = "BBD"
+	BDT CurrencyCode = "BDT"
+	BGN CurrencyCode = "BGN"
+	BHD CurrencyCode = "BHD"
+	BIF CurrencyCode = "BIF"
+	BMD CurrencyCode = "BMD"
+	BND CurrencyCode = "BND"
+	BOB CurrencyCode = "BOB"
+	BRL CurrencyCode = "BRL"
+	BSD CurrencyCode = "BSD"
+	BTN CurrencyCode = "BTN"
+	BWP CurrencyCode = "BWP"
+	BYN CurrencyCode = "BYN"
+	BYR CurrencyCode = "BYR"
+	BZD CurrencyCode = "BZD"
+	CAD CurrencyCode = "CAD"
+	CDF CurrencyCode = "CDF"
+	CHF CurrencyCode = "CHF"
+	CLF CurrencyCode = "CLF"
+	CLP CurrencyCode = "CLP"
+	CNY CurrencyCode = "CNY"
+	COP CurrencyCode = "COP"
+	CRC CurrencyCode = "CRC"
+	CUC CurrencyCode = "CUC"
+	CUP CurrencyCode = "CUP"
+	CVE CurrencyCode = "CVE"
+	CZK CurrencyCode = "CZK"
+	DJF CurrencyCode = "DJF"
+	DKK CurrencyCode = "DKK"
+	DOP CurrencyCode = "DOP"
+	DZD CurrencyCode = "DZD"
+	EEK CurrencyCode = "EEK"
+	EGP CurrencyCode = "EGP"
+	ERN CurrencyCode = "ERN"
+	ETB CurrencyCode = "ETB"
+	EUR CurrencyCode = "EUR"
+	FJD CurrencyCode = "FJD"
+	FKP CurrencyCode = "FKP"
+	GBP CurrencyCode = "GBP"
+	GEL CurrencyCode = "GEL"
+	GGP CurrencyCode = "GGP"
+	GHC CurrencyCode = "GHC"
+	GHS CurrencyCode = "GHS"
+	GIP CurrencyCode = "GIP"
+	GMD CurrencyCode = "GMD"
+	GNF CurrencyCode = "GNF"
+	GTQ CurrencyCode = "GTQ"
+	GYD CurrencyCode = "GYD"
+	HKD CurrencyCode = "HKD"
+	HNL CurrencyCode = "HNL"
+	HRK CurrencyCode = "HRK"
+	HTG CurrencyCode = "HTG"
+	HUF CurrencyCode = "HUF"
+	IDR CurrencyCode = "IDR"
+	ILS CurrencyCode = "ILS"
+	IMP CurrencyCode = "IMP"
+	INR CurrencyCode = "INR"
+	IQD CurrencyCode = "IQD"
+	IRR CurrencyCode = "IRR"
+	ISK CurrencyCode = "ISK"
+	JEP CurrencyCode = "JEP"
+	JMD CurrencyCode = "JMD"
+	JOD CurrencyCode = "JOD"
+	JPY CurrencyCode = "JPY"
+	KES CurrencyCode = "KES"
+	KGS CurrencyCode = "KGS"
+	KHR CurrencyCode = "KHR"
+	KMF CurrencyCode = "KMF"
+	KPW CurrencyCode = "KPW"
+	KRW CurrencyCode = "KRW"
+	KWD CurrencyCode = "KWD"
+	KYD CurrencyCode = "KYD"
+	KZT CurrencyCode = "KZT"
+	LAK CurrencyCode = "LAK"
+	LBP CurrencyCode = "LBP"
+	LKR CurrencyCode = "LKR"
+	LRD CurrencyCode = "LRD"
+	LSL CurrencyCode = "LSL"
+	LTL CurrencyCode = "LTL"
+	LVL CurrencyCode = "LVL"
+	LYD CurrencyCode = "LYD"
+	MAD CurrencyCode = "MAD"
+	MDL CurrencyCode = "MDL"
+	MKD CurrencyCode = "MKD"
+	MMK CurrencyCode = "MMK"
+	MNT CurrencyCode = "MNT"
+	MOP CurrencyCode = "MOP"
+	MRU CurrencyCode = "MRU"
+	MUR CurrencyCode = "MUR"
+	MVR CurrencyCode = "MVR"
+	MWK CurrencyCode = "MWK"
+	MXN CurrencyCode = "MXN"
+	MYR CurrencyCode = "MYR"
+	MZN CurrencyCode = "MZN"
+	NAD CurrencyCode = "NAD"
+	NGN CurrencyCode = "NGN"
+	NIO CurrencyCode = "NIO"
+	NOK CurrencyCode = "NOK"
+	NPR CurrencyCode = "NPR"
+	NZD CurrencyCode = "NZD"
+	OMR CurrencyCode = "OMR"
+	PAB CurrencyCode = "PAB"
+	PEN CurrencyCode = "PEN"
+	PGK CurrencyCode = "PGK"
+	PHP CurrencyCode = "PHP"
+	PKR CurrencyCode = "PKR"
+	PLN CurrencyCode = "PLN"
+	PYG CurrencyCode = "PYG"
+	QAR CurrencyCode = "QAR"
+	RON CurrencyCode = "RON"
+	RSD CurrencyCode = "RSD"
+	RUB CurrencyCode = "RUB"
+	RUR CurrencyCode = "RUR"
+	RWF CurrencyCode = "RWF"
+	SAR CurrencyCode = "SAR"
+	SBD CurrencyCode = "SBD"
+	SCR CurrencyCode = "SCR"
+	SDG CurrencyCode = "SDG"
+	SEK CurrencyCode = "SEK"
+	SGD CurrencyCode = "SGD"
+	SHP CurrencyCode = "SHP"
+	SKK CurrencyCode = "SKK"
+	SLE CurrencyCode = "SLE"
+	SLL CurrencyCode = "SLL"
+	SOS CurrencyCode = "SOS"
+	SRD CurrencyCode = "SRD"
+	SSP CurrencyCode = "SSP"
+	STD CurrencyCode = "STD"
+	STN CurrencyCode = "STN"
+	SVC CurrencyCode = "SVC"
+	SYP CurrencyCode = "SYP"
+	SZL CurrencyCode = "SZL"
+	THB CurrencyCode = "THB"
+	TJS CurrencyCode = "TJS"
+	TMT CurrencyCode = "TMT"
+	TND CurrencyCode = "TND"
+	TOP CurrencyCode = "TOP"
+	TRL CurrencyCode = "TRL"
+	TRY CurrencyCode = "TRY"
+	TTD CurrencyCode = "TTD"
+	TWD CurrencyCode = "TWD"
+	TZS CurrencyCode = "TZS"
+	UAH CurrencyCode = "UAH"
+	UGX CurrencyCode = "UGX"
+	USD CurrencyCode = "USD"
+	UYU CurrencyCode = "UYU"
+	UZS CurrencyCode = "UZS"
+	VEF CurrencyCode = "VEF"
+	VES CurrencyCode = "VES"
+	VND CurrencyCode = "VND"
+	VUV CurrencyCode = "VUV"
+	WST CurrencyCode = "WST"
+	XAF CurrencyCode = "XAF"
+	XAG CurrencyCode = "XAG"
+	XAU CurrencyCode = "XAU"
+	XCD CurrencyCode = "XCD"
+	XDR CurrencyCode = "XDR"
+	XPF CurrencyCode = "XPF"
+	YER CurrencyCode = "YER"
+	ZAR CurrencyCode = "ZAR"
+	ZMW CurrencyCode = "ZMW"
+	ZWD CurrencyCode = "ZWD"
+	ZWG CurrencyCode = "ZWG"
+	ZWL CurrencyCode = "ZWL"
+)
@@ -1,9 +1,15 @@
 package monies_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
 	"github.com/Craftserve/monies"
 	"github.com/stretchr/testify/assert"
-	"testing"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCurrencyGetNonExistingCurrency(t *testing.T) {
@@ -12,7 +18,7 @@ func TestCurrencyGetNonExistingCurrency(t *testing.T) {
 }
 
 func TestCurrencyGetCurrencyByNumericCode(t *testing.T) {
-	desired := monies.Currency{Decimal: ",", Thousand: ".", Code: monies.HUF, Fraction: 0, NumericCode: "348", Grapheme: "Ft", Template: "1 $"}
+	desired := monies.Currency{Decimal: ",", Thousand: ".", Code: monies.HUF, Fraction: 0, NumericCode: "348", Grapheme: "Ft", Pattern: "#,##0 ¤", CashRounding: 5, Name: "Forint", Countries: monies.NewCountryList("HU")}
 	currency, err := monies.CurrencyByNumericCode("348")
 
 	assert.NoError(t, err)
@@ -23,3 +29,303 @@ func TestCurrencyCurrencyByNumericCodeNonExisting(t *testing.T) {
 	_, err := monies.CurrencyByNumericCode("0900990")
 	assert.ErrorIs(t, err, monies.ErrCurrencyNotFound)
 }
+
+func TestRegisterCurrency(t *testing.T) {
+	credits := monies.Currency{Code: "XCR", NumericCode: "", Fraction: 2, Grapheme: "CR", Pattern: "#,##0.00 ¤", Decimal: ".", Thousand: ","}
+	require.NoError(t, monies.RegisterCurrency(credits))
+	t.Cleanup(func() { _ = monies.UnregisterCurrency("XCR") })
+
+	currency, err := monies.CurrencyByCode("XCR")
+	require.NoError(t, err)
+	assert.Equal(t, credits, currency)
+
+	m, err := monies.New(123456, "XCR")
+	require.NoError(t, err)
+	assert.Equal(t, "1,234.56 CR", m.String())
+
+	var decoded monies.Money
+	require.NoError(t, json.Unmarshal([]byte(`{"amount": 100, "currency": "XCR"}`), &decoded))
+	assert.Equal(t, monies.MustNew(100, "XCR"), decoded)
+
+	text, err := m.MarshalText()
+	require.NoError(t, err)
+	require.NoError(t, decoded.UnmarshalText(text))
+	assert.Equal(t, m, decoded)
+
+	err = monies.RegisterCurrency(credits)
+	assert.ErrorIs(t, err, monies.ErrCurrencyExists)
+}
+
+func TestRegisterCurrencyLongCode(t *testing.T) {
+	gems := monies.Currency{Code: "GEMS", Fraction: 0, Grapheme: "gems", Pattern: "#,##0 ¤", Decimal: "."}
+	require.NoError(t, monies.RegisterCurrency(gems))
+	t.Cleanup(func() { _ = monies.UnregisterCurrency("GEMS") })
+
+	m := monies.MustNew(1500, "GEMS")
+	text, err := m.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "1500.0 GEMS", string(text))
+
+	var decoded monies.Money
+	require.NoError(t, decoded.UnmarshalText(text))
+	assert.Equal(t, m, decoded)
+}
+
+func TestRegisterCurrencyValidation(t *testing.T) {
+	valid := monies.Currency{Code: "XVA", NumericCode: "999", Fraction: 2, Grapheme: "V", Pattern: "¤#,##0.00", Decimal: ".", Thousand: ","}
+
+	testCases := []struct {
+		Name        string
+		Modify      func(c *monies.Currency)
+		ExpectedErr error
+	}{
+		{"EMPTY_CODE", func(c *monies.Currency) { c.Code = "" }, monies.ErrInvalidCurrency},
+		{"LOWER_CASE_CODE", func(c *monies.Currency) { c.Code = "xva" }, monies.ErrInvalidCurrency},
+		{"CODE_WITH_SPACE", func(c *monies.Currency) { c.Code = "X A" }, monies.ErrInvalidCurrency},
+		{"EXISTING_CODE", func(c *monies.Currency) { c.Code = monies.EUR }, monies.ErrCurrencyExists},
+		{"EXISTING_NUMERIC_CODE", func(c *monies.Currency) { c.NumericCode = "978" }, monies.ErrCurrencyExists},
+		{"SHORT_NUMERIC_CODE", func(c *monies.Currency) { c.NumericCode = "99" }, monies.ErrInvalidCurrency},
+		{"ALPHA_NUMERIC_CODE", func(c *monies.Currency) { c.NumericCode = "9A9" }, monies.ErrInvalidCurrency},
+		{"NEGATIVE_FRACTION", func(c *monies.Currency) { c.Fraction = -1 }, monies.ErrInvalidCurrency},
+		{"HUGE_FRACTION", func(c *monies.Currency) { c.Fraction = 19 }, monies.ErrInvalidCurrency},
+		{"NO_DECIMAL", func(c *monies.Currency) { c.Decimal = "" }, monies.ErrInvalidCurrency},
+		{"EMPTY_PATTERN", func(c *monies.Currency) { c.Pattern = "" }, monies.ErrInvalidCurrency},
+		{"PATTERN_WITHOUT_DIGITS", func(c *monies.Currency) { c.Pattern = "¤" }, monies.ErrInvalidCurrency},
+		{"PATTERN_WITH_EMPTY_GROUP", func(c *monies.Currency) { c.Pattern = "¤#,##0,.00" }, monies.ErrInvalidCurrency},
+		{"NEGATIVE_CASH_ROUNDING", func(c *monies.Currency) { c.CashRounding = -5 }, monies.ErrInvalidCurrency},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Name, func(t *testing.T) {
+			c := valid
+			tC.Modify(&c)
+
+			err := monies.RegisterCurrency(c)
+			assert.ErrorIs(t, err, tC.ExpectedErr)
+		})
+	}
+
+	_, err := monies.CurrencyByNumericCode("999")
+	assert.ErrorIs(t, err, monies.ErrCurrencyNotFound)
+}
+
+func TestRegisterCurrencyTemplate(t *testing.T) {
+	c := monies.Currency{Code: "XTP", Fraction: 3, Grapheme: "T", Template: "$1", Decimal: ".", Thousand: ","}
+	require.NoError(t, monies.RegisterCurrency(c))
+	t.Cleanup(func() { _ = monies.UnregisterCurrency("XTP") })
+
+	registered, err := monies.CurrencyByCode("XTP")
+	require.NoError(t, err)
+	assert.Equal(t, "¤#,##0.000", registered.Pattern)
+	assert.Equal(t, "T1,234.567", monies.MustNew(1234567, "XTP").String())
+
+	// A Pattern takes precedence over a Template.
+	r, err := monies.NewRegistry(monies.Currency{Code: "XTP", Fraction: 0, Grapheme: "T", Pattern: "#,##0 ¤", Template: "$1", Decimal: "."})
+	require.NoError(t, err)
+	m, err := r.New(5, "XTP")
+	require.NoError(t, err)
+	assert.Equal(t, "5 T", m.String())
+}
+
+func TestUnregisterCurrency(t *testing.T) {
+	c := monies.Currency{Code: "XUN", NumericCode: "998", Fraction: 0, Grapheme: "U", Pattern: "#,##0 ¤", Decimal: "."}
+	require.NoError(t, monies.RegisterCurrency(c))
+
+	m := monies.MustNew(5, "XUN")
+
+	require.NoError(t, monies.UnregisterCurrency("XUN"))
+	assert.ErrorIs(t, monies.UnregisterCurrency("XUN"), monies.ErrCurrencyNotFound)
+
+	_, err := monies.New(5, "XUN")
+	assert.ErrorIs(t, err, monies.ErrCurrencyNotFound)
+
+	_, err = monies.CurrencyByNumericCode("998")
+	assert.ErrorIs(t, err, monies.ErrCurrencyNotFound)
+
+	// Existing amounts keep working.
+	assert.Equal(t, "5 U", m.String())
+}
+
+func TestRegisterCurrencyConcurrently(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			code := monies.CurrencyCode(fmt.Sprintf("XC%d", i))
+			c := monies.Currency{Code: code, Fraction: 2, Grapheme: "C", Pattern: "#,##0.00 ¤", Decimal: "."}
+			for j := 0; j < 50; j++ {
+				assert.NoError(t, monies.RegisterCurrency(c))
+				_, err := monies.New(1, code)
+				assert.NoError(t, err)
+				_, err = monies.CurrencyByNumericCode("978")
+				assert.NoError(t, err)
+				assert.NoError(t, monies.UnregisterCurrency(code))
+			}
+		}(i)
+	}
+
+	wg.Wait()
+}
+
+func TestCurrencyByCodeAt(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Code        monies.CurrencyCode
+		At          time.Time
+		ExpectedErr error
+	}{
+		{"IN_USE", monies.ZWD, time.Date(2005, 6, 1, 0, 0, 0, 0, time.UTC), nil},
+		{"INTRODUCED", monies.ZWD, time.Date(1980, 4, 18, 0, 0, 0, 0, time.UTC), nil},
+		{"WITHDRAWN", monies.ZWD, time.Date(2009, 2, 2, 0, 0, 0, 0, time.UTC), monies.ErrCurrencyNotInUse},
+		{"BEFORE_INTRODUCED", monies.EUR, time.Date(1998, 12, 31, 0, 0, 0, 0, time.UTC), monies.ErrCurrencyNotInUse},
+		{"OPEN_ENDED", monies.USD, time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), nil},
+		{"NOT_FOUND", "XXX", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), monies.ErrCurrencyNotFound},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Name, func(t *testing.T) {
+			c, err := monies.CurrencyByCodeAt(tC.Code, tC.At)
+			if tC.ExpectedErr != nil {
+				assert.ErrorIs(t, err, tC.ExpectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tC.Code, c.Code)
+		})
+	}
+
+	c, err := monies.CurrencyByCodeAt(monies.ZWD, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.ErrorIs(t, err, monies.ErrCurrencyNotInUse)
+	assert.Equal(t, monies.CurrencyWithdrawn, c.Status)
+	assert.Equal(t, monies.ZWL, c.ReplacedBy)
+}
+
+func TestWithdrawnCurrencies(t *testing.T) {
+	for code, successor := range map[monies.CurrencyCode]monies.CurrencyCode{
+		monies.GHC: monies.GHS,
+		monies.RUR: monies.RUB,
+		monies.SKK: monies.EUR,
+		monies.TRL: monies.TRY,
+		monies.ZWD: monies.ZWL,
+	} {
+		c, err := monies.CurrencyByCode(code)
+		require.NoError(t, err)
+		assert.Equal(t, monies.CurrencyWithdrawn, c.Status, code)
+		assert.Equal(t, successor, c.ReplacedBy, code)
+	}
+
+	// Every currency past its Withdrawn date is marked as withdrawn and the
+	// other way round.
+	for _, c := range monies.AllCurrencies() {
+		assert.Equal(t, c.Status == monies.CurrencyWithdrawn, !c.Withdrawn.IsZero(), c.Code)
+	}
+}
+
+func TestCurrenciesByCountry(t *testing.T) {
+	codes := func(cs []monies.Currency) []monies.CurrencyCode {
+		var result []monies.CurrencyCode
+		for _, c := range cs {
+			result = append(result, c.Code)
+		}
+		return result
+	}
+
+	assert.Equal(t, []monies.CurrencyCode{monies.PLN}, codes(monies.CurrenciesByCountry("PL")))
+	assert.Equal(t, []monies.CurrencyCode{monies.BGN, monies.EUR}, codes(monies.CurrenciesByCountry("BG")))
+	assert.Equal(t, []monies.CurrencyCode{monies.PAB, monies.USD}, codes(monies.CurrenciesByCountry("PA")))
+	assert.Empty(t, monies.CurrenciesByCountry("pl"))
+
+	pln, err := monies.CurrencyByCode(monies.PLN)
+	require.NoError(t, err)
+	assert.Equal(t, "Polish Złoty", pln.Name)
+	assert.Equal(t, "grosz", pln.MinorUnitName)
+}
+
+func TestCurrencyComparable(t *testing.T) {
+	chf, err := monies.CurrencyByCode(monies.CHF)
+	require.NoError(t, err)
+	again, err := monies.CurrencyByCode(monies.CHF)
+	require.NoError(t, err)
+
+	assert.True(t, chf == again)
+	assert.Equal(t, 1, map[monies.Currency]int{chf: 1}[again])
+
+	assert.Equal(t, 2, chf.Countries.Len())
+	assert.True(t, chf.Countries.Contains("LI"))
+	assert.False(t, chf.Countries.Contains("C"))
+	assert.Equal(t, "CH LI", chf.Countries.String())
+
+	codes := chf.Countries.Codes()
+	codes[0] = "XX"
+	assert.Equal(t, []string{"CH", "LI"}, again.Countries.Codes())
+	assert.Empty(t, monies.NewCountryList().Codes())
+}
+
+func TestCurrencyJSON(t *testing.T) {
+	for _, code := range []monies.CurrencyCode{monies.CHF, monies.EEK, monies.XAU} {
+		c, err := monies.CurrencyByCode(code)
+		require.NoError(t, err)
+
+		data, err := json.Marshal(c)
+		require.NoError(t, err)
+
+		var decoded monies.Currency
+		require.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, c, decoded, code)
+	}
+
+	var l monies.CountryList
+	require.NoError(t, json.Unmarshal([]byte(`"CH, LI"`), &l))
+	assert.Equal(t, []string{"CH", "LI"}, l.Codes())
+
+	data, err := json.Marshal(l)
+	require.NoError(t, err)
+	assert.Equal(t, `"CH,LI"`, string(data))
+}
+
+func TestPrimaryCurrencyForCountry(t *testing.T) {
+	testCases := []struct {
+		Country  string
+		At       time.Time
+		Expected monies.CurrencyCode
+	}{
+		{"PL", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), monies.PLN},
+		{"DE", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), monies.EUR},
+		{"BG", time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC), monies.BGN},
+		{"BG", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), monies.EUR},
+		{"HR", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), monies.HRK},
+		{"EE", time.Date(2005, 1, 1, 0, 0, 0, 0, time.UTC), monies.EEK},
+		{"EE", time.Date(2011, 1, 1, 0, 0, 0, 0, time.UTC), monies.EUR},
+		{"LT", time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC), monies.LTL},
+		{"LV", time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC), monies.LVL},
+		{"SK", time.Date(2005, 1, 1, 0, 0, 0, 0, time.UTC), monies.SKK},
+		{"BY", time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC), monies.BYR},
+		{"ST", time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC), monies.STD},
+		{"GH", time.Date(2005, 1, 1, 0, 0, 0, 0, time.UTC), monies.GHC},
+		{"PA", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), monies.PAB},
+		{"EC", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), monies.USD},
+		{"ZW", time.Date(2005, 1, 1, 0, 0, 0, 0, time.UTC), monies.ZWD},
+		{"ZW", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), monies.ZWL},
+		{"ZW", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), monies.ZWG},
+		{"JE", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), monies.GBP},
+		{"CL", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), monies.CLP},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Country+"_"+tC.At.Format("2006"), func(t *testing.T) {
+			c, err := monies.PrimaryCurrencyForCountryAt(tC.Country, tC.At)
+			require.NoError(t, err)
+			assert.Equal(t, tC.Expected, c.Code)
+		})
+	}
+
+	_, err := monies.PrimaryCurrencyForCountryAt("AQ", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.ErrorIs(t, err, monies.ErrCurrencyNotFound)
+
+	// PrimaryCurrencyForCountry looks at the currencies in use today.
+	c, err := monies.PrimaryCurrencyForCountry("PL")
+	require.NoError(t, err)
+	assert.Equal(t, monies.PLN, c.Code)
+}
@@ -0,0 +1,80 @@
+package monies
+
+import (
+	"errors"
+	"math"
+	"math/big"
+)
+
+var ErrDivisionByZero = errors.New("division by zero")
+
+// Divide returns m divided by divisor and rounded with mode.
+func (m Money) Divide(divisor int64, mode RoundingMode) (Money, error) {
+	if divisor <= 0 {
+		return m.DivideRate(new(big.Rat).SetInt64(divisor), mode)
+	}
+
+	if !mode.valid() {
+		return m, ErrInvalidRoundingMode
+	}
+
+	return Money{amount: divRound(m.amount, divisor, mode), currency: m.currency}, nil
+}
+
+// DivideRate returns m divided by an exact divisor and rounded with mode.
+func (m Money) DivideRate(divisor *big.Rat, mode RoundingMode) (Money, error) {
+	if divisor == nil {
+		return m, ErrInvalidDecimal
+	}
+
+	if divisor.Sign() == 0 {
+		return m, ErrDivisionByZero
+	}
+
+	exact := new(big.Rat).SetInt64(m.amount)
+	result, _, err := m.fromRat(exact.Quo(exact, divisor), mode)
+
+	return result, err
+}
+
+// DivideDecimal works like DivideRate, but takes the divisor as a decimal
+// string such as "1.23".
+func (m Money) DivideDecimal(divisor string, mode RoundingMode) (Money, error) {
+	d, err := parseDecimal(divisor)
+	if err != nil {
+		return m, err
+	}
+
+	return m.DivideRate(d, mode)
+}
+
+// DivMod divides m by n with truncation towards zero and returns the
+// quotient and the remainder. The remainder has the sign of m, so that
+// quotient*n + remainder always equals m.
+func (m Money) DivMod(n int64) (Money, Money, error) {
+	if n == 0 {
+		return m, m, ErrDivisionByZero
+	}
+
+	if n == -1 && m.amount == math.MinInt64 {
+		return m, m, ErrOverflow
+	}
+
+	q := Money{amount: m.amount / n, currency: m.currency}
+	r := Money{amount: m.amount % n, currency: m.currency}
+
+	return q, r, nil
+}
+
+// Ratio returns the exact proportion m/om.
+func (m Money) Ratio(om Money) (*big.Rat, error) {
+	if err := m.assertSameCurrency(om); err != nil {
+		return nil, err
+	}
+
+	if om.IsZero() {
+		return nil, ErrDivisionByZero
+	}
+
+	return new(big.Rat).SetFrac(big.NewInt(m.amount), big.NewInt(om.amount)), nil
+}
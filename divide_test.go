@@ -0,0 +1,126 @@
+package monies_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/Craftserve/monies"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDivide(t *testing.T) {
+	testCases := []struct {
+		Money       monies.Money
+		Divisor     int64
+		Mode        monies.RoundingMode
+		Expected    int64
+		ExpectedErr error
+	}{
+		{monies.MustNew(100, monies.EUR), 3, monies.RoundHalfUp, 33, nil},
+		{monies.MustNew(100, monies.EUR), 3, monies.RoundUp, 34, nil},
+		{monies.MustNew(5, monies.EUR), 2, monies.RoundHalfEven, 2, nil},
+		{monies.MustNew(-5, monies.EUR), 2, monies.RoundHalfUp, -3, nil},
+		{monies.MustNew(100, monies.EUR), -3, monies.RoundFloor, -34, nil},
+		{monies.MustNew(math.MinInt64, monies.EUR), -1, monies.RoundHalfUp, 0, monies.ErrOverflow},
+		{monies.MustNew(100, monies.EUR), 0, monies.RoundHalfUp, 0, monies.ErrDivisionByZero},
+		{monies.MustNew(100, monies.EUR), 3, monies.RoundingMode(99), 0, monies.ErrInvalidRoundingMode},
+	}
+
+	for index, tC := range testCases {
+		t.Run(fmt.Sprintf("#%d", index), func(t *testing.T) {
+			result, err := tC.Money.Divide(tC.Divisor, tC.Mode)
+			assert.ErrorIs(t, err, tC.ExpectedErr)
+
+			if tC.ExpectedErr == nil {
+				assert.Equal(t, tC.Expected, result.Amount())
+			}
+		})
+	}
+}
+
+func TestDivideDecimal(t *testing.T) {
+	testCases := []struct {
+		Money       monies.Money
+		Divisor     string
+		Mode        monies.RoundingMode
+		Expected    int64
+		ExpectedErr error
+	}{
+		{monies.MustNew(12300, monies.EUR), "1.23", monies.RoundHalfUp, 10000, nil},
+		{monies.MustNew(10000, monies.EUR), "1.95583", monies.RoundHalfUp, 5113, nil},
+		{monies.MustNew(100, monies.EUR), "0.5", monies.RoundHalfUp, 200, nil},
+		{monies.MustNew(100, monies.EUR), "0.000", monies.RoundHalfUp, 0, monies.ErrDivisionByZero},
+		{monies.MustNew(100, monies.EUR), "abc", monies.RoundHalfUp, 0, monies.ErrInvalidDecimal},
+	}
+
+	for index, tC := range testCases {
+		t.Run(fmt.Sprintf("#%d", index), func(t *testing.T) {
+			result, err := tC.Money.DivideDecimal(tC.Divisor, tC.Mode)
+			assert.ErrorIs(t, err, tC.ExpectedErr)
+
+			if tC.ExpectedErr == nil {
+				assert.Equal(t, tC.Expected, result.Amount())
+			}
+		})
+	}
+
+	_, err := monies.MustNew(100, monies.EUR).DivideRate(nil, monies.RoundHalfUp)
+	assert.ErrorIs(t, err, monies.ErrInvalidDecimal)
+}
+
+func TestDivMod(t *testing.T) {
+	testCases := []struct {
+		Money             monies.Money
+		Divisor           int64
+		ExpectedQuotient  int64
+		ExpectedRemainder int64
+		ExpectedErr       error
+	}{
+		{monies.MustNew(100, monies.EUR), 3, 33, 1, nil},
+		{monies.MustNew(-100, monies.EUR), 3, -33, -1, nil},
+		{monies.MustNew(100, monies.EUR), -3, -33, 1, nil},
+		{monies.MustNew(99, monies.EUR), 3, 33, 0, nil},
+		{monies.MustNew(100, monies.EUR), 0, 0, 0, monies.ErrDivisionByZero},
+		{monies.MustNew(math.MinInt64, monies.EUR), -1, 0, 0, monies.ErrOverflow},
+	}
+
+	for index, tC := range testCases {
+		t.Run(fmt.Sprintf("#%d", index), func(t *testing.T) {
+			q, r, err := tC.Money.DivMod(tC.Divisor)
+			assert.ErrorIs(t, err, tC.ExpectedErr)
+
+			if tC.ExpectedErr == nil {
+				assert.Equal(t, tC.ExpectedQuotient, q.Amount())
+				assert.Equal(t, tC.ExpectedRemainder, r.Amount())
+				assert.Equal(t, tC.Money.Amount(), q.Amount()*tC.Divisor+r.Amount())
+			}
+		})
+	}
+}
+
+func TestRatio(t *testing.T) {
+	testCases := []struct {
+		Money       monies.Money
+		OtherMoney  monies.Money
+		Expected    *big.Rat
+		ExpectedErr error
+	}{
+		{monies.MustNew(25, monies.EUR), monies.MustNew(100, monies.EUR), big.NewRat(1, 4), nil},
+		{monies.MustNew(-300, monies.EUR), monies.MustNew(200, monies.EUR), big.NewRat(-3, 2), nil},
+		{monies.MustNew(100, monies.EUR), monies.MustNew(100, monies.USD), nil, monies.ErrCurrencyMismatch},
+		{monies.MustNew(100, monies.EUR), monies.MustNew(0, monies.EUR), nil, monies.ErrDivisionByZero},
+	}
+
+	for index, tC := range testCases {
+		t.Run(fmt.Sprintf("#%d", index), func(t *testing.T) {
+			ratio, err := tC.Money.Ratio(tC.OtherMoney)
+			assert.ErrorIs(t, err, tC.ExpectedErr)
+
+			if tC.ExpectedErr == nil {
+				assert.Equal(t, 0, tC.Expected.Cmp(ratio))
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package monies
+
+import (
+	"errors"
+	"sync"
+)
+
+var ErrInvalidDualLayout = errors.New("invalid dual display layout")
+
+// DualLayout selects the order in which DualFormatter shows an amount and
+// its equivalent.
+type DualLayout int
+
+const (
+	// AmountFirst shows the amount before its equivalent: "19.56 лв / 10.00 €".
+	AmountFirst DualLayout = iota
+	// EquivalentFirst shows the equivalent before the amount: "10.00 € / 19.56 лв".
+	EquivalentFirst
+)
+
+func (l DualLayout) valid() bool {
+	return l == AmountFirst || l == EquivalentFirst
+}
+
+// DefaultDualSeparator is put between the two amounts when DualOptions
+// leaves Separator empty.
+const DefaultDualSeparator = " / "
+
+// DualOptions configures how an amount and its equivalent are shown.
+type DualOptions struct {
+	Layout    DualLayout
+	Separator string
+	// Mode rounds the equivalent to the minor unit of the paired currency.
+	Mode RoundingMode
+}
+
+type dualPair struct {
+	rd   *Redenomination
+	opts DualOptions
+}
+
+// DualFormatter renders amounts together with their equivalent in a paired
+// currency at a fixed rate, as required during a currency changeover.
+// Amounts in currencies without a pairing are rendered by Money.String.
+type DualFormatter struct {
+	mu    sync.RWMutex
+	pairs map[CurrencyCode]dualPair
+}
+
+// NewDualFormatter returns a DualFormatter without any pairings.
+func NewDualFormatter() *DualFormatter {
+	return &DualFormatter{pairs: make(map[CurrencyCode]dualPair)}
+}
+
+// EuroChangeoverFormatter returns a DualFormatter that shows amounts in the
+// national currency code with their euro equivalent and amounts in euro
+// with their equivalent in code, both at the irrevocable conversion rate.
+func EuroChangeoverFormatter(code CurrencyCode, opts DualOptions) (*DualFormatter, error) {
+	rd, err := EuroChangeover(code)
+	if err != nil {
+		return nil, err
+	}
+
+	f := NewDualFormatter()
+	if err := f.Pair(rd, opts); err != nil {
+		return nil, err
+	}
+	if err := f.Pair(rd.Inverse(), opts); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// Pair makes f show amounts in rd.From() together with their equivalent in
+// rd.To(), replacing any earlier pairing of rd.From().
+func (f *DualFormatter) Pair(rd *Redenomination, opts DualOptions) error {
+	if !opts.Layout.valid() {
+		return ErrInvalidDualLayout
+	}
+
+	if !opts.Mode.valid() {
+		return ErrInvalidRoundingMode
+	}
+
+	if opts.Separator == "" {
+		opts.Separator = DefaultDualSeparator
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.pairs[rd.from.Code] = dualPair{rd: rd, opts: opts}
+	return nil
+}
+
+// Unpair removes the pairing of the given currency.
+func (f *DualFormatter) Unpair(code CurrencyCode) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.pairs, code)
+}
+
+// Format renders m together with its equivalent in the paired currency.
+func (f *DualFormatter) Format(m Money) (string, error) {
+	f.mu.RLock()
+	p, ok := f.pairs[m.cur().Code]
+	f.mu.RUnlock()
+
+	if !ok {
+		return m.String(), nil
+	}
+
+	equivalent, _, err := p.rd.convert(m, p.opts.Mode)
+	if err != nil {
+		return "", err
+	}
+
+	if p.opts.Layout == EquivalentFirst {
+		return equivalent.String() + p.opts.Separator + m.String(), nil
+	}
+
+	return m.String() + p.opts.Separator + equivalent.String(), nil
+}
@@ -0,0 +1,53 @@
+package monies_test
+
+import (
+	"testing"
+
+	"github.com/Craftserve/monies"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDualFormatter(t *testing.T) {
+	f, err := monies.EuroChangeoverFormatter(monies.BGN, monies.DualOptions{})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		Name     string
+		Amount   monies.Money
+		Expected string
+	}{
+		{"BGN", monies.MustNew(1956, monies.BGN), "лв19.56 / €10.00"},
+		{"EUR", monies.MustNew(1000, monies.EUR), "€10.00 / лв19.56"},
+		{"NEGATIVE", monies.MustNew(-1, monies.EUR), "-€0.01 / -лв0.02"},
+		{"UNPAIRED", monies.MustNew(1000, monies.USD), "$10.00"},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Name, func(t *testing.T) {
+			s, err := f.Format(tC.Amount)
+			require.NoError(t, err)
+			assert.Equal(t, tC.Expected, s)
+		})
+	}
+
+	f.Unpair(monies.EUR)
+	s, err := f.Format(monies.MustNew(1000, monies.EUR))
+	require.NoError(t, err)
+	assert.Equal(t, "€10.00", s)
+}
+
+func TestDualFormatterOptions(t *testing.T) {
+	rd, err := monies.EuroChangeover(monies.BGN)
+	require.NoError(t, err)
+
+	f := monies.NewDualFormatter()
+	require.NoError(t, f.Pair(rd, monies.DualOptions{Layout: monies.EquivalentFirst, Separator: " = ", Mode: monies.RoundCeiling}))
+
+	s, err := f.Format(monies.MustNew(1956, monies.BGN))
+	require.NoError(t, err)
+	assert.Equal(t, "€10.01 = лв19.56", s)
+
+	assert.ErrorIs(t, f.Pair(rd, monies.DualOptions{Layout: monies.DualLayout(2)}), monies.ErrInvalidDualLayout)
+	assert.ErrorIs(t, f.Pair(rd, monies.DualOptions{Mode: monies.RoundingMode(-1)}), monies.ErrInvalidRoundingMode)
+}
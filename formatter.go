@@ -0,0 +1,197 @@
+package monies
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+var (
+	ErrInvalidCurrencyDisplay = errors.New("invalid currency display")
+	ErrInvalidNegativeStyle   = errors.New("invalid negative style")
+	ErrInvalidFractionDigits  = errors.New("invalid fraction digits")
+)
+
+// CurrencyDisplay selects how Formatter shows the currency of an amount.
+type CurrencyDisplay int
+
+const (
+	// DisplaySymbol shows the grapheme of the currency: "$5.00".
+	DisplaySymbol CurrencyDisplay = iota
+	// DisplayCode shows the ISO code of the currency: "USD 5.00".
+	DisplayCode
+	// DisplayName shows the name of the currency after the amount:
+	// "5.00 US Dollar". Currencies without a name are shown by code.
+	DisplayName
+)
+
+func (d CurrencyDisplay) valid() bool {
+	return d >= DisplaySymbol && d <= DisplayName
+}
+
+// NegativeStyle selects how Formatter marks negative amounts.
+type NegativeStyle int
+
+const (
+	// MinusBeforeSymbol puts the minus sign in front: "-$5.00".
+	MinusBeforeSymbol NegativeStyle = iota
+	// MinusAfterSymbol puts the minus sign next to the number: "$-5.00".
+	MinusAfterSymbol
+	// Parentheses encloses negative amounts in parentheses, as in
+	// accounting: "($5.00)".
+	Parentheses
+)
+
+func (s NegativeStyle) valid() bool {
+	return s >= MinusBeforeSymbol && s <= Parentheses
+}
+
+// FractionDigits bounds the number of fraction digits Formatter shows.
+type FractionDigits struct {
+	Min int
+	Max int
+}
+
+// FormatOptions configures a Formatter. The zero value renders amounts like
+// Money.String.
+type FormatOptions struct {
+	Display  CurrencyDisplay
+	Negative NegativeStyle
+	// ShowPlus marks positive amounts with a plus sign, placed where the
+	// minus sign of MinusBeforeSymbol or MinusAfterSymbol would be. Zero
+	// is never signed.
+	ShowPlus bool
+	// HideZeroFraction leaves out the fraction of whole amounts: "$5"
+	// rather than "$5.00".
+	HideZeroFraction bool
+	// Fraction bounds the number of fraction digits; nil shows as many as
+	// the currency has. Amounts are rounded with Mode to at most Max digits
+	// and trailing zeros are dropped down to Min digits.
+	Fraction *FractionDigits
+	Mode     RoundingMode
+}
+
+// Formatter renders amounts using the display conventions of their currency
+// with configurable currency display, sign styles and fraction digits.
+type Formatter struct {
+	opts FormatOptions
+}
+
+// NewFormatter returns a Formatter configured by opts.
+func NewFormatter(opts FormatOptions) (*Formatter, error) {
+	if !opts.Display.valid() {
+		return nil, ErrInvalidCurrencyDisplay
+	}
+
+	if !opts.Negative.valid() {
+		return nil, ErrInvalidNegativeStyle
+	}
+
+	if !opts.Mode.valid() {
+		return nil, ErrInvalidRoundingMode
+	}
+
+	if fd := opts.Fraction; fd != nil {
+		if fd.Min < 0 || fd.Min > fd.Max || fd.Max > maxFraction {
+			return nil, fmt.Errorf("%w: min %d, max %d", ErrInvalidFractionDigits, fd.Min, fd.Max)
+		}
+		opts.Fraction = &FractionDigits{Min: fd.Min, Max: fd.Max}
+	}
+
+	return &Formatter{opts: opts}, nil
+}
+
+// Format renders m. It fails only when rounding m overflows.
+//
+// The prefix, suffix and grouping come from the positive pattern of the
+// currency; negative amounts are marked as selected by Negative.
+func (f *Formatter) Format(m Money) (string, error) {
+	c := m.cur()
+	minDigits, maxDigits := c.Fraction, c.Fraction
+	if f.opts.Fraction != nil {
+		minDigits, maxDigits = f.opts.Fraction.Min, f.opts.Fraction.Max
+	}
+
+	m, err := m.RoundTo(maxDigits, f.opts.Mode)
+	if err != nil {
+		return "", err
+	}
+
+	var sign string
+	switch {
+	case m.amount < 0:
+		sign = "-"
+	case m.amount > 0 && f.opts.ShowPlus:
+		sign = "+"
+	}
+
+	p, _ := cachedPattern(c.Pattern)
+	s := patternSymbols{decimal: c.Decimal, group: c.Thousand, minus: "-", currency: c.Grapheme}
+	switch f.opts.Display {
+	case DisplayCode:
+		s.currency, s.spacing = string(c.Code), " "
+	case DisplayName:
+		p.posPrefix, p.posSuffix = "", " ¤"
+		s.currency = c.Name
+		if s.currency == "" {
+			s.currency = string(c.Code)
+		}
+	}
+
+	dst := make([]byte, 0, 32)
+	switch {
+	case sign == "-" && f.opts.Negative == Parentheses:
+		dst = append(dst, '(')
+	case f.opts.Negative != MinusAfterSymbol:
+		dst = append(dst, sign...)
+	}
+
+	dst = appendPrefix(dst, p.posPrefix, s)
+	if f.opts.Negative == MinusAfterSymbol {
+		dst = append(dst, sign...)
+	}
+	dst = f.appendNumber(dst, m, &p, minDigits, maxDigits)
+	dst = appendSuffix(dst, p.posSuffix, s)
+
+	if sign == "-" && f.opts.Negative == Parentheses {
+		dst = append(dst, ')')
+	}
+
+	return string(dst), nil
+}
+
+// appendNumber appends the magnitude of m, already rounded to maxDigits, with
+// at least minDigits fraction digits and the grouping of p to dst.
+func (f *Formatter) appendNumber(dst []byte, m Money, p *numberPattern, minDigits, maxDigits int) []byte {
+	c := m.cur()
+
+	var buf [20]byte
+	digits := strconv.AppendUint(buf[:0], magnitude(m.amount), 10)
+	for len(digits) <= c.Fraction {
+		digits = append([]byte{'0'}, digits...)
+	}
+
+	integer, fraction := digits[:len(digits)-c.Fraction], digits[len(digits)-c.Fraction:]
+	if len(fraction) > maxDigits {
+		fraction = fraction[:maxDigits]
+	}
+	for len(fraction) > minDigits && fraction[len(fraction)-1] == '0' {
+		fraction = fraction[:len(fraction)-1]
+	}
+	if f.opts.HideZeroFraction && len(bytes.TrimRight(fraction, "0")) == 0 {
+		fraction = nil
+	} else {
+		for len(fraction) < minDigits {
+			fraction = append(fraction, '0')
+		}
+	}
+
+	dst = appendDigits(dst, integer, 0, "", c.Thousand, p.primary, p.secondary)
+	if len(fraction) > 0 {
+		dst = append(dst, c.Decimal...)
+		dst = append(dst, fraction...)
+	}
+
+	return dst
+}
@@ -0,0 +1,88 @@
+package monies_test
+
+import (
+	"testing"
+
+	"github.com/Craftserve/monies"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatter(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		opts     monies.FormatOptions
+		m        monies.Money
+		expected string
+	}{
+		{"DEFAULT", monies.FormatOptions{}, monies.MustNew(-123456, monies.USD), "-$1,234.56"},
+		{"DEFAULT_SUFFIX", monies.FormatOptions{}, monies.MustNew(123456, monies.PLN), "1,234.56 zł"},
+		{"CODE", monies.FormatOptions{Display: monies.DisplayCode}, monies.MustNew(500, monies.USD), "USD 5.00"},
+		{"CODE_SUFFIX", monies.FormatOptions{Display: monies.DisplayCode}, monies.MustNew(500, monies.PLN), "5.00 PLN"},
+		{"NAME", monies.FormatOptions{Display: monies.DisplayName}, monies.MustNew(500, monies.USD), "5.00 US Dollar"},
+		{"NAME_NEGATIVE", monies.FormatOptions{Display: monies.DisplayName, Negative: monies.MinusAfterSymbol}, monies.MustNew(-500, monies.USD), "-5.00 US Dollar"},
+		{"HIDE_ZERO_FRACTION", monies.FormatOptions{HideZeroFraction: true}, monies.MustNew(500, monies.USD), "$5"},
+		{"HIDE_ZERO_FRACTION_NON_ZERO", monies.FormatOptions{HideZeroFraction: true}, monies.MustNew(550, monies.USD), "$5.50"},
+		{"PLUS", monies.FormatOptions{ShowPlus: true}, monies.MustNew(500, monies.USD), "+$5.00"},
+		{"PLUS_ZERO", monies.FormatOptions{ShowPlus: true}, monies.MustNew(0, monies.USD), "$0.00"},
+		{"PLUS_AFTER_SYMBOL", monies.FormatOptions{ShowPlus: true, Negative: monies.MinusAfterSymbol}, monies.MustNew(500, monies.USD), "$+5.00"},
+		{"PARENTHESES", monies.FormatOptions{Negative: monies.Parentheses}, monies.MustNew(-1200, monies.USD), "($12.00)"},
+		{"PARENTHESES_POSITIVE", monies.FormatOptions{Negative: monies.Parentheses}, monies.MustNew(1200, monies.USD), "$12.00"},
+		{"PARENTHESES_PLUS", monies.FormatOptions{Negative: monies.Parentheses, ShowPlus: true}, monies.MustNew(1200, monies.USD), "+$12.00"},
+		{"MINUS_AFTER_SYMBOL", monies.FormatOptions{Negative: monies.MinusAfterSymbol}, monies.MustNew(-500, monies.USD), "$-5.00"},
+		{"MINUS_AFTER_SYMBOL_SUFFIX", monies.FormatOptions{Negative: monies.MinusAfterSymbol}, monies.MustNew(-500, monies.PLN), "-5.00 zł"},
+		{"MAX_FRACTION", monies.FormatOptions{Fraction: &monies.FractionDigits{Min: 0, Max: 0}}, monies.MustNew(550, monies.USD), "$6"},
+		{"MAX_FRACTION_MODE", monies.FormatOptions{Fraction: &monies.FractionDigits{Min: 0, Max: 0}, Mode: monies.RoundDown}, monies.MustNew(550, monies.USD), "$5"},
+		{"MAX_FRACTION_TO_ZERO", monies.FormatOptions{Fraction: &monies.FractionDigits{Min: 0, Max: 1}}, monies.MustNew(-4, monies.USD), "$0"},
+		{"MIN_FRACTION", monies.FormatOptions{Display: monies.DisplayCode, Fraction: &monies.FractionDigits{Min: 1, Max: 3}}, monies.MustNew(1500, monies.KWD), "1.5 KWD"},
+		{"MIN_FRACTION_PADDED", monies.FormatOptions{Fraction: &monies.FractionDigits{Min: 4, Max: 4}}, monies.MustNew(150, monies.USD), "$1.5000"},
+		{"NO_FRACTION_CURRENCY", monies.FormatOptions{}, monies.MustNew(1234567, monies.JPY), "¥1,234,567"},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Name, func(t *testing.T) {
+			f, err := monies.NewFormatter(tC.opts)
+			require.NoError(t, err)
+
+			s, err := f.Format(tC.m)
+			require.NoError(t, err)
+			assert.Equal(t, tC.expected, s)
+		})
+	}
+}
+
+func TestFormatterMatchesString(t *testing.T) {
+	f, err := monies.NewFormatter(monies.FormatOptions{})
+	require.NoError(t, err)
+
+	for _, c := range monies.AllCurrencies() {
+		for _, amount := range []int64{0, 5, -150, 123456789} {
+			m := monies.MustNew(amount, c.Code)
+
+			s, err := f.Format(m)
+			require.NoError(t, err)
+			assert.Equal(t, m.String(), s)
+		}
+	}
+}
+
+func TestNewFormatterErrors(t *testing.T) {
+	testCases := []struct {
+		Name string
+		opts monies.FormatOptions
+		err  error
+	}{
+		{"DISPLAY", monies.FormatOptions{Display: -1}, monies.ErrInvalidCurrencyDisplay},
+		{"NEGATIVE", monies.FormatOptions{Negative: 3}, monies.ErrInvalidNegativeStyle},
+		{"MODE", monies.FormatOptions{Mode: 42}, monies.ErrInvalidRoundingMode},
+		{"MIN_ABOVE_MAX", monies.FormatOptions{Fraction: &monies.FractionDigits{Min: 3, Max: 2}}, monies.ErrInvalidFractionDigits},
+		{"NEGATIVE_MIN", monies.FormatOptions{Fraction: &monies.FractionDigits{Min: -1, Max: 2}}, monies.ErrInvalidFractionDigits},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Name, func(t *testing.T) {
+			_, err := monies.NewFormatter(tC.opts)
+			assert.ErrorIs(t, err, tC.err)
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package monies
+
+import (
+	"errors"
+	"math/big"
+)
+
+var (
+	ErrNegativeAmount      = errors.New("amount must not be negative")
+	ErrInvalidLimit        = errors.New("invalid allocation limit")
+	ErrLimitsUnsatisfiable = errors.New("allocation limits cannot be satisfied")
+)
+
+// Limit bounds the part a single party receives from AllocateLimited.
+type Limit struct {
+	// Min is the guaranteed minimum of the party, nil for none.
+	Min *Money
+	// Max caps the part of the party, nil for no cap.
+	Max *Money
+}
+
+// AllocateLimited works like Allocate, but keeps the part of every party
+// within its limit. A party whose proportional part exceeds its cap is fixed
+// at the cap and the excess is redistributed among the remaining parties in
+// proportion to their ratios. Likewise a party whose part falls below its
+// guaranteed minimum is raised to it at the expense of the others. The
+// process repeats until every part is within limits.
+//
+// Leftover minor units are handed out with LargestRemainder, so rounding
+// never pushes a part across its limit. m must not be negative and limits
+// must have one entry per ratio. ErrLimitsUnsatisfiable is returned when no
+// allocation can respect all limits.
+func (m Money) AllocateLimited(limits []Limit, rs ...int) ([]Money, error) {
+	if len(rs) == 0 {
+		return nil, ErrNoRatios
+	}
+
+	if m.amount < 0 {
+		return nil, ErrNegativeAmount
+	}
+
+	if len(limits) != len(rs) {
+		return nil, ErrInvalidLimit
+	}
+
+	weights, err := intWeights(rs)
+	if err != nil {
+		return nil, err
+	}
+
+	lo := make([]*big.Int, len(rs))
+	hi := make([]*big.Int, len(rs))
+	sumMin, sumMax, uncapped := new(big.Int), new(big.Int), false
+	for i, l := range limits {
+		if weights[i].Sign() < 0 {
+			return nil, ErrNegativeRatio
+		}
+
+		if lo[i], err = m.limitBound(l.Min); err != nil {
+			return nil, err
+		}
+		if hi[i], err = m.limitBound(l.Max); err != nil {
+			return nil, err
+		}
+
+		if lo[i] == nil {
+			lo[i] = new(big.Int)
+		}
+		if hi[i] != nil && hi[i].Cmp(lo[i]) < 0 {
+			return nil, ErrInvalidLimit
+		}
+
+		sumMin.Add(sumMin, lo[i])
+		if hi[i] == nil {
+			uncapped = true
+		} else {
+			sumMax.Add(sumMax, hi[i])
+		}
+	}
+
+	amount := big.NewInt(m.amount)
+	if sumMin.Cmp(amount) > 0 || !uncapped && sumMax.Cmp(amount) < 0 {
+		return nil, ErrLimitsUnsatisfiable
+	}
+
+	parts := make([]*big.Int, len(rs))
+	for {
+		// Distribute what is left after the fixed parties among the free ones.
+		remaining, weight := new(big.Int).Set(amount), new(big.Int)
+		for i, p := range parts {
+			if p != nil {
+				remaining.Sub(remaining, p)
+			} else {
+				weight.Add(weight, weights[i])
+			}
+		}
+
+		// Free parties without any weight only receive their minimum.
+		if weight.Sign() == 0 {
+			raised := false
+			for i, p := range parts {
+				if p == nil && lo[i].Sign() > 0 {
+					parts[i], raised = lo[i], true
+				}
+			}
+
+			if !raised {
+				return m.fillFree(parts, weights, remaining, weight)
+			}
+			continue
+		}
+
+		// Find parties whose proportional part, remaining*w/weight, crosses
+		// a limit. Excess and deficit are kept scaled by weight.
+		var over, under []int
+		excess, deficit := new(big.Int), new(big.Int)
+		for i, p := range parts {
+			if p != nil {
+				continue
+			}
+
+			scaled := new(big.Int).Mul(remaining, weights[i])
+			if hi[i] != nil {
+				if d := new(big.Int).Sub(scaled, new(big.Int).Mul(hi[i], weight)); d.Sign() > 0 {
+					over = append(over, i)
+					excess.Add(excess, d)
+				}
+			}
+
+			if d := new(big.Int).Sub(new(big.Int).Mul(lo[i], weight), scaled); d.Sign() > 0 {
+				under = append(under, i)
+				deficit.Add(deficit, d)
+			}
+		}
+
+		if len(over) == 0 && len(under) == 0 {
+			return m.fillFree(parts, weights, remaining, weight)
+		}
+
+		// Fixing the side with the larger violation moves the proportional
+		// parts of the others in a direction that keeps it violated.
+		c := excess.Cmp(deficit)
+		if c >= 0 {
+			for _, i := range over {
+				parts[i] = hi[i]
+			}
+		}
+		if c <= 0 {
+			for _, i := range under {
+				parts[i] = lo[i]
+			}
+		}
+	}
+}
+
+// limitBound validates a single bound of a Limit.
+func (m Money) limitBound(b *Money) (*big.Int, error) {
+	if b == nil {
+		return nil, nil
+	}
+
+	if err := m.assertSameCurrency(*b); err != nil {
+		return nil, err
+	}
+
+	if b.amount < 0 {
+		return nil, ErrInvalidLimit
+	}
+
+	return big.NewInt(b.amount), nil
+}
+
+// fillFree allocates remaining among the parties not fixed at a limit yet.
+func (m Money) fillFree(parts, weights []*big.Int, remaining, weight *big.Int) ([]Money, error) {
+	var free []int
+	for i, p := range parts {
+		if p == nil {
+			free = append(free, i)
+		}
+	}
+
+	if weight.Sign() == 0 {
+		if remaining.Sign() != 0 {
+			return nil, ErrLimitsUnsatisfiable
+		}
+
+		for _, i := range free {
+			parts[i] = new(big.Int)
+		}
+
+		return m.fromParts(parts), nil
+	}
+
+	ws := make([]*big.Int, len(free))
+	for k, i := range free {
+		ws[k] = weights[i]
+	}
+
+	shares, err := allocateInt(remaining, ws, LargestRemainder)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, i := range free {
+		parts[i] = shares[k]
+	}
+
+	return m.fromParts(parts), nil
+}
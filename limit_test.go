@@ -0,0 +1,109 @@
+package monies_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/Craftserve/monies"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func eur(amount int64) *monies.Money {
+	m := monies.MustNew(amount, monies.EUR)
+	return &m
+}
+
+func TestAllocateLimited(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Money       monies.Money
+		Ratios      []int
+		Limits      []monies.Limit
+		Expected    []int64
+		ExpectedErr error
+	}{
+		{"NO_LIMITS", monies.MustNew(100, monies.EUR), []int{1, 1, 1}, make([]monies.Limit, 3), []int64{34, 33, 33}, nil},
+		{"CAP", monies.MustNew(1000, monies.EUR), []int{1, 1, 1}, []monies.Limit{{Max: eur(200)}, {}, {}}, []int64{200, 400, 400}, nil},
+		{"CAP_CASCADE", monies.MustNew(1000, monies.EUR), []int{1, 1, 2}, []monies.Limit{{Max: eur(100)}, {Max: eur(300)}, {}}, []int64{100, 300, 600}, nil},
+		{"CAP_ROUNDING", monies.MustNew(100, monies.EUR), []int{1, 1, 1}, []monies.Limit{{Max: eur(33)}, {}, {}}, []int64{33, 34, 33}, nil},
+		{"MIN", monies.MustNew(1000, monies.EUR), []int{1, 9}, []monies.Limit{{Min: eur(300)}, {}}, []int64{300, 700}, nil},
+		{"MIN_NOT_NEEDED", monies.MustNew(1000, monies.EUR), []int{1, 1}, []monies.Limit{{Min: eur(300)}, {}}, []int64{500, 500}, nil},
+		{"MIN_ZERO_RATIO", monies.MustNew(1000, monies.EUR), []int{0, 1}, []monies.Limit{{Min: eur(100)}, {}}, []int64{100, 900}, nil},
+		{"MIN_AND_CAP", monies.MustNew(1000, monies.EUR), []int{1, 1, 2}, []monies.Limit{{Max: eur(100)}, {Min: eur(400)}, {}}, []int64{100, 400, 500}, nil},
+		{"ALL_CAPPED_EXACT", monies.MustNew(300, monies.EUR), []int{5, 1}, []monies.Limit{{Max: eur(100)}, {Max: eur(200)}}, []int64{100, 200}, nil},
+		{"MIN_EXCEEDS_AMOUNT", monies.MustNew(100, monies.EUR), []int{1, 1}, []monies.Limit{{Min: eur(60)}, {Min: eur(60)}}, nil, monies.ErrLimitsUnsatisfiable},
+		{"CAPS_BELOW_AMOUNT", monies.MustNew(1000, monies.EUR), []int{1, 1}, []monies.Limit{{Max: eur(400)}, {Max: eur(500)}}, nil, monies.ErrLimitsUnsatisfiable},
+		{"ONLY_ZERO_RATIO_UNCAPPED", monies.MustNew(1000, monies.EUR), []int{1, 0}, []monies.Limit{{Max: eur(400)}, {}}, nil, monies.ErrLimitsUnsatisfiable},
+		{"MIN_ABOVE_MAX", monies.MustNew(1000, monies.EUR), []int{1, 1}, []monies.Limit{{Min: eur(400), Max: eur(300)}, {}}, nil, monies.ErrInvalidLimit},
+		{"NEGATIVE_LIMIT", monies.MustNew(1000, monies.EUR), []int{1, 1}, []monies.Limit{{Max: eur(-1)}, {}}, nil, monies.ErrInvalidLimit},
+		{"LIMIT_COUNT", monies.MustNew(1000, monies.EUR), []int{1, 1}, []monies.Limit{{}}, nil, monies.ErrInvalidLimit},
+		{"CURRENCY_MISMATCH", monies.MustNew(1000, monies.EUR), []int{1, 1}, []monies.Limit{{Max: &[]monies.Money{monies.MustNew(1, monies.USD)}[0]}, {}}, nil, monies.ErrCurrencyMismatch},
+		{"NEGATIVE_AMOUNT", monies.MustNew(-1000, monies.EUR), []int{1, 1}, make([]monies.Limit, 2), nil, monies.ErrNegativeAmount},
+		{"NEGATIVE_RATIO", monies.MustNew(1000, monies.EUR), []int{1, -1}, make([]monies.Limit, 2), nil, monies.ErrNegativeRatio},
+		{"NO_RATIOS", monies.MustNew(1000, monies.EUR), []int{}, nil, nil, monies.ErrNoRatios},
+		{"RATIO_SUM_OVERFLOW", monies.MustNew(1000, monies.EUR), []int{math.MaxInt64, 1}, make([]monies.Limit, 2), nil, monies.ErrOverflow},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Name, func(t *testing.T) {
+			result, err := tC.Money.AllocateLimited(tC.Limits, tC.Ratios...)
+			assert.ErrorIs(t, err, tC.ExpectedErr)
+
+			if tC.ExpectedErr == nil {
+				var rs []int64
+				for _, party := range result {
+					rs = append(rs, party.Amount())
+				}
+
+				assert.Equal(t, tC.Expected, rs)
+			}
+		})
+	}
+}
+
+func TestAllocateLimitedInvariants(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+
+	for run := 0; run < 500; run++ {
+		n := 1 + rnd.Intn(6)
+		amount := rnd.Int63n(100000)
+		rs := make([]int, n)
+		limits := make([]monies.Limit, n)
+		for i := range rs {
+			rs[i] = rnd.Intn(10)
+			if rnd.Intn(3) == 0 {
+				limits[i].Min = eur(rnd.Int63n(amount/int64(n) + 1))
+			}
+			if rnd.Intn(2) == 0 {
+				min := int64(0)
+				if limits[i].Min != nil {
+					min = limits[i].Min.Amount()
+				}
+				limits[i].Max = eur(min + rnd.Int63n(amount+1))
+			}
+		}
+
+		m := monies.MustNew(amount, monies.EUR)
+		parts, err := m.AllocateLimited(limits, rs...)
+		if err != nil {
+			require.ErrorIs(t, err, monies.ErrLimitsUnsatisfiable, fmt.Sprint(amount, rs))
+			continue
+		}
+
+		var total int64
+		for i, p := range parts {
+			total += p.Amount()
+			if limits[i].Min != nil {
+				assert.GreaterOrEqual(t, p.Amount(), limits[i].Min.Amount())
+			}
+			if limits[i].Max != nil {
+				assert.LessOrEqual(t, p.Amount(), limits[i].Max.Amount())
+			}
+		}
+
+		assert.Equal(t, amount, total, "ratios %v", rs)
+	}
+}
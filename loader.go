@@ -0,0 +1,520 @@
+package monies
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RowError describes an invalid record of a currency data file.
+type RowError struct {
+	// Row is the 1-based position of the record: the line number for CSV
+	// files and the index of the entry for JSON and XML files.
+	Row  int
+	Code CurrencyCode
+	Err  error
+}
+
+func (e *RowError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("row %d: %v", e.Row, e.Err)
+	}
+
+	return fmt.Sprintf("row %d (%s): %v", e.Row, e.Code, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// RowErrors lists every invalid record of a currency data file.
+type RowErrors []*RowError
+
+func (es RowErrors) Error() string {
+	msgs := make([]string, len(es))
+	for i, e := range es {
+		msgs[i] = e.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// Is reports whether any of the row errors matches target.
+func (es RowErrors) Is(target error) bool {
+	for _, e := range es {
+		if errors.Is(e, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// currencyRecord is a currency as stored in JSON and CSV data files.
+type currencyRecord struct {
+	Code          CurrencyCode `json:"code"`
+	NumericCode   string       `json:"numeric_code"`
+	Fraction      int          `json:"fraction"`
+	Grapheme      string       `json:"grapheme"`
+	Pattern       string       `json:"pattern"`
+	Decimal       string       `json:"decimal"`
+	Thousand      string       `json:"thousand"`
+	CashRounding  int          `json:"cash_rounding"`
+	Status        string       `json:"status"`
+	Introduced    string       `json:"introduced"`
+	Withdrawn     string       `json:"withdrawn"`
+	ReplacedBy    CurrencyCode `json:"replaced_by"`
+	Name          string       `json:"name"`
+	MinorUnitName string       `json:"minor_unit_name"`
+	Countries     []string     `json:"countries"`
+	// Template is the form of Pattern used before patterns, still accepted
+	// in data files. See templatePattern.
+	Template string `json:"template"`
+}
+
+// dateLayout is the layout of the introduced and withdrawn dates.
+const dateLayout = "2006-01-02"
+
+func (rec currencyRecord) currency() (c Currency, err error) {
+	c = Currency{
+		Code:          rec.Code,
+		NumericCode:   rec.NumericCode,
+		Fraction:      rec.Fraction,
+		Grapheme:      rec.Grapheme,
+		Pattern:       rec.Pattern,
+		Decimal:       rec.Decimal,
+		Thousand:      rec.Thousand,
+		CashRounding:  rec.CashRounding,
+		ReplacedBy:    rec.ReplacedBy,
+		Name:          rec.Name,
+		MinorUnitName: rec.MinorUnitName,
+		Countries:     NewCountryList(rec.Countries...),
+	}
+
+	switch {
+	case rec.Template != "" && rec.Pattern != "":
+		return c, fmt.Errorf("%w: both pattern and template given", ErrInvalidCurrency)
+	case rec.Template != "":
+		c.Pattern = templatePattern(rec.Template, rec.Fraction)
+	}
+
+	switch rec.Status {
+	case "", "active":
+		c.Status = CurrencyActive
+	case "withdrawn":
+		c.Status = CurrencyWithdrawn
+	default:
+		return c, fmt.Errorf("%w: unknown status %q", ErrInvalidCurrency, rec.Status)
+	}
+
+	if c.Introduced, err = parseDate(rec.Introduced); err != nil {
+		return c, fmt.Errorf("%w: introduced: %v", ErrInvalidCurrency, err)
+	}
+	if c.Withdrawn, err = parseDate(rec.Withdrawn); err != nil {
+		return c, fmt.Errorf("%w: withdrawn: %v", ErrInvalidCurrency, err)
+	}
+
+	return c, nil
+}
+
+// parseDate parses a date in dateLayout as UTC midnight; an empty string
+// stands for the zero time.
+func parseDate(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(dateLayout, s)
+}
+
+// tableBuilder collects the currencies of a data file, checking each of them
+// and the uniqueness of codes and numeric codes across the file.
+type tableBuilder struct {
+	currencies []Currency
+	codes      map[CurrencyCode]int
+	numeric    map[string]CurrencyCode
+	errs       RowErrors
+}
+
+func newTableBuilder() *tableBuilder {
+	return &tableBuilder{
+		codes:   make(map[CurrencyCode]int),
+		numeric: make(map[string]CurrencyCode),
+	}
+}
+
+func (b *tableBuilder) fail(row int, code CurrencyCode, err error) {
+	b.errs = append(b.errs, &RowError{Row: row, Code: code, Err: err})
+}
+
+func (b *tableBuilder) add(row int, c Currency) {
+	if err := validateCurrency(&c); err != nil {
+		b.fail(row, c.Code, err)
+		return
+	}
+
+	if first, ok := b.codes[c.Code]; ok {
+		b.fail(row, c.Code, fmt.Errorf("%w: code %s is also defined in row %d", ErrCurrencyExists, c.Code, first))
+		return
+	}
+
+	if c.NumericCode != "" {
+		if other, ok := b.numeric[c.NumericCode]; ok {
+			b.fail(row, c.Code, fmt.Errorf("%w: numeric code %s is used by %s", ErrCurrencyExists, c.NumericCode, other))
+			return
+		}
+		b.numeric[c.NumericCode] = c.Code
+	}
+
+	b.codes[c.Code] = row
+	b.currencies = append(b.currencies, c)
+}
+
+func (b *tableBuilder) result() ([]Currency, error) {
+	if len(b.errs) > 0 {
+		return nil, b.errs
+	}
+
+	return b.currencies, nil
+}
+
+// ReadCurrenciesJSON reads a currency table from a JSON array of objects with
+// the keys code, numeric_code, fraction, grapheme, pattern, decimal,
+// thousand, cash_rounding, status, introduced, withdrawn, replaced_by, name,
+// minor_unit_name and countries. Status is "active" or "withdrawn", dates use
+// the YYYY-MM-DD form and countries is an array of ISO 3166-1 alpha-2 codes.
+// Invalid entries are reported together as RowErrors.
+//
+// Instead of pattern, an entry may have a template in the former "1 $" form,
+// where the first "1" stands for the amount and the first "$" for the
+// grapheme; it is converted to the equivalent pattern.
+func ReadCurrenciesJSON(r io.Reader) ([]Currency, error) {
+	var raw []json.RawMessage
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	b := newTableBuilder()
+	for i, msg := range raw {
+		var rec currencyRecord
+		dec := json.NewDecoder(strings.NewReader(string(msg)))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&rec); err != nil {
+			b.fail(i+1, "", err)
+			continue
+		}
+
+		c, err := rec.currency()
+		if err != nil {
+			b.fail(i+1, rec.Code, err)
+			continue
+		}
+
+		b.add(i+1, c)
+	}
+
+	return b.result()
+}
+
+// csvColumns maps the CSV header names to the fields they fill.
+var csvColumns = map[string]func(rec *currencyRecord, v string) error{
+	"code":         func(rec *currencyRecord, v string) error { rec.Code = CurrencyCode(v); return nil },
+	"numeric_code": func(rec *currencyRecord, v string) error { rec.NumericCode = v; return nil },
+	"fraction":     func(rec *currencyRecord, v string) (err error) { rec.Fraction, err = strconv.Atoi(v); return err },
+	"grapheme":     func(rec *currencyRecord, v string) error { rec.Grapheme = v; return nil },
+	"pattern":      func(rec *currencyRecord, v string) error { rec.Pattern = v; return nil },
+	"template":     func(rec *currencyRecord, v string) error { rec.Template = v; return nil },
+	"decimal":      func(rec *currencyRecord, v string) error { rec.Decimal = v; return nil },
+	"thousand":     func(rec *currencyRecord, v string) error { rec.Thousand = v; return nil },
+	"cash_rounding": func(rec *currencyRecord, v string) (err error) {
+		if v == "" {
+			return nil
+		}
+		rec.CashRounding, err = strconv.Atoi(v)
+		return err
+	},
+	"status":          func(rec *currencyRecord, v string) error { rec.Status = v; return nil },
+	"introduced":      func(rec *currencyRecord, v string) error { rec.Introduced = v; return nil },
+	"withdrawn":       func(rec *currencyRecord, v string) error { rec.Withdrawn = v; return nil },
+	"replaced_by":     func(rec *currencyRecord, v string) error { rec.ReplacedBy = CurrencyCode(v); return nil },
+	"name":            func(rec *currencyRecord, v string) error { rec.Name = v; return nil },
+	"minor_unit_name": func(rec *currencyRecord, v string) error { rec.MinorUnitName = v; return nil },
+	"countries": func(rec *currencyRecord, v string) error {
+		if v != "" {
+			rec.Countries = strings.Fields(v)
+		}
+		return nil
+	},
+}
+
+// csvRequired lists the columns every CSV currency table must have, along
+// with either pattern or template.
+var csvRequired = []string{"code", "fraction", "decimal"}
+
+// ReadCurrenciesCSV reads a currency table from CSV. The first line is a
+// header naming the columns, which are the keys used by ReadCurrenciesJSON;
+// code, fraction, pattern (or template) and decimal are required. The
+// countries column separates the country codes with spaces. Invalid lines
+// are reported together as RowErrors.
+func ReadCurrenciesCSV(r io.Reader) ([]Currency, error) {
+	cr := csv.NewReader(r)
+	// Rows with a wrong number of fields are reported as a RowError below
+	// instead of aborting the whole file.
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	setters := make([]func(rec *currencyRecord, v string) error, len(header))
+	seen := make(map[string]bool, len(header))
+	codeColumn := 0
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		setter, ok := csvColumns[name]
+		if !ok || seen[name] {
+			return nil, &RowError{Row: 1, Err: fmt.Errorf("%w: unexpected column %q", ErrInvalidCurrency, name)}
+		}
+		setters[i], seen[name] = setter, true
+		if name == "code" {
+			codeColumn = i
+		}
+	}
+
+	for _, name := range csvRequired {
+		if !seen[name] {
+			return nil, &RowError{Row: 1, Err: fmt.Errorf("%w: missing column %q", ErrInvalidCurrency, name)}
+		}
+	}
+	if !seen["pattern"] && !seen["template"] {
+		return nil, &RowError{Row: 1, Err: fmt.Errorf("%w: missing column \"pattern\"", ErrInvalidCurrency)}
+	}
+
+	b := newTableBuilder()
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		row, _ := cr.FieldPos(0)
+
+		if len(record) != len(header) {
+			var code CurrencyCode
+			if codeColumn < len(record) {
+				code = CurrencyCode(record[codeColumn])
+			}
+			b.fail(row, code, fmt.Errorf("%w: %d fields, want %d", ErrInvalidCurrency, len(record), len(header)))
+			continue
+		}
+
+		var rec currencyRecord
+		valid := true
+		for i, v := range record {
+			if err := setters[i](&rec, v); err != nil {
+				b.fail(row, CurrencyCode(record[codeColumn]), fmt.Errorf("%w: column %s: %v", ErrInvalidCurrency, header[i], err))
+				valid = false
+				break
+			}
+		}
+
+		if !valid {
+			continue
+		}
+
+		c, err := rec.currency()
+		if err != nil {
+			b.fail(row, rec.Code, err)
+			continue
+		}
+
+		b.add(row, c)
+	}
+
+	return b.result()
+}
+
+// iso4217Table is the ISO 4217 "list one" XML document.
+type iso4217Table struct {
+	Entries []struct {
+		Country    string `xml:"CtryNm"`
+		Name       string `xml:"CcyNm"`
+		Code       string `xml:"Ccy"`
+		Number     string `xml:"CcyNbr"`
+		MinorUnits string `xml:"CcyMnrUnts"`
+	} `xml:"CcyTbl>CcyNtry"`
+}
+
+// ReadISO4217XML reads the currencies of the ISO 4217 "list one" XML file as
+// published by the maintenance agency. The list only defines codes, numbers
+// and minor units, so the display conventions are kept from the currencies
+// already in r; currencies new to r are shown as "1 CODE" with "." and ","
+// separators. Entries without a currency, such as Antarctica, are skipped,
+// and minor units of "N.A." read as 0.
+//
+// The currencies are returned rather than applied; pass them to Update.
+func (r *Registry) ReadISO4217XML(rd io.Reader) ([]Currency, error) {
+	var table iso4217Table
+	if err := xml.NewDecoder(rd).Decode(&table); err != nil {
+		return nil, err
+	}
+
+	b := newTableBuilder()
+	parsed := make(map[CurrencyCode]Currency)
+	for i, e := range table.Entries {
+		row, code := i+1, CurrencyCode(strings.TrimSpace(e.Code))
+		if code == "" {
+			continue
+		}
+
+		fraction := 0
+		if units := strings.TrimSpace(e.MinorUnits); units != "N.A." {
+			var err error
+			fraction, err = strconv.Atoi(units)
+			if err != nil {
+				b.fail(row, code, fmt.Errorf("%w: minor units %q", ErrInvalidCurrency, e.MinorUnits))
+				continue
+			}
+		}
+
+		c, err := r.ByCode(code)
+		if err != nil {
+			c = Currency{Code: code, Grapheme: string(code), Pattern: templatePattern("1 $", fraction), Decimal: ".", Thousand: ",", Name: strings.TrimSpace(e.Name)}
+		}
+		c.NumericCode, c.Fraction = strings.TrimSpace(e.Number), fraction
+
+		// The list has an entry per country, so a currency may repeat.
+		if prev, ok := parsed[code]; ok {
+			if prev.NumericCode != c.NumericCode || prev.Fraction != c.Fraction {
+				b.fail(row, code, fmt.Errorf("%w: %s conflicts with an earlier entry", ErrInvalidCurrency, e.Country))
+			}
+			continue
+		}
+		parsed[code] = c
+
+		b.add(row, c)
+	}
+
+	return b.result()
+}
+
+// cldrFile is a file of the CLDR JSON distribution, such as
+// cldr-numbers-full/main/pl/numbers.json, holding the data of one locale.
+type cldrFile struct {
+	Main map[string]struct {
+		Identity struct {
+			Language  string `json:"language"`
+			Script    string `json:"script"`
+			Territory string `json:"territory"`
+		} `json:"identity"`
+		Numbers map[string]json.RawMessage `json:"numbers"`
+	} `json:"main"`
+}
+
+// readCLDR decodes a CLDR JSON file and returns the tag of its locale and
+// its numbers section.
+func readCLDR(r io.Reader) (string, map[string]json.RawMessage, error) {
+	var f cldrFile
+	if err := json.NewDecoder(r).Decode(&f); err != nil {
+		return "", nil, err
+	}
+
+	if len(f.Main) != 1 {
+		return "", nil, fmt.Errorf("%w: CLDR file must hold exactly one locale, got %d", ErrInvalidLocale, len(f.Main))
+	}
+
+	var tag string
+	var numbers map[string]json.RawMessage
+	for _, l := range f.Main {
+		tag, numbers = l.Identity.Language, l.Numbers
+		for _, part := range []string{l.Identity.Script, l.Identity.Territory} {
+			if part != "" {
+				tag += "-" + part
+			}
+		}
+	}
+
+	return tag, numbers, nil
+}
+
+// ReadLocaleCLDR reads a locale from the CLDR JSON numbers.json file of the
+// cldr-numbers packages and, optionally, the currencies.json file of the
+// same locale, which provides the currency symbols. currencies may be nil.
+// The separators and the standard currency pattern are taken from the
+// default numbering system of the locale.
+//
+// The locale is returned rather than registered; pass it to RegisterLocale.
+func ReadLocaleCLDR(numbers, currencies io.Reader) (Locale, error) {
+	tag, ns, err := readCLDR(numbers)
+	if err != nil {
+		return Locale{}, err
+	}
+
+	system := "latn"
+	if raw, ok := ns["defaultNumberingSystem"]; ok {
+		if err := json.Unmarshal(raw, &system); err != nil {
+			return Locale{}, err
+		}
+	}
+
+	var symbols struct {
+		Decimal   string `json:"decimal"`
+		Group     string `json:"group"`
+		MinusSign string `json:"minusSign"`
+	}
+	var formats struct {
+		Standard string `json:"standard"`
+	}
+	for key, v := range map[string]interface{}{"symbols-numberSystem-" + system: &symbols, "currencyFormats-numberSystem-" + system: &formats} {
+		raw, ok := ns[key]
+		if !ok {
+			return Locale{}, fmt.Errorf("%w: %s: no %s", ErrInvalidLocale, tag, key)
+		}
+		if err := json.Unmarshal(raw, v); err != nil {
+			return Locale{}, fmt.Errorf("%w: %s: %s: %v", ErrInvalidLocale, tag, key, err)
+		}
+	}
+
+	l := Locale{Tag: tag, Decimal: symbols.Decimal, Group: symbols.Group, Minus: symbols.MinusSign, Pattern: formats.Standard}
+
+	if currencies != nil {
+		ctag, cs, err := readCLDR(currencies)
+		if err != nil {
+			return Locale{}, err
+		}
+		if ctag != tag {
+			return Locale{}, fmt.Errorf("%w: currencies of %s given for %s", ErrInvalidLocale, ctag, tag)
+		}
+
+		var entries map[CurrencyCode]struct {
+			Symbol string `json:"symbol"`
+		}
+		if raw, ok := cs["currencies"]; ok {
+			if err := json.Unmarshal(raw, &entries); err != nil {
+				return Locale{}, fmt.Errorf("%w: %s: currencies: %v", ErrInvalidLocale, tag, err)
+			}
+		}
+
+		l.Symbols = make(map[CurrencyCode]string, len(entries))
+		for code, e := range entries {
+			if e.Symbol != "" {
+				l.Symbols[code] = e.Symbol
+			}
+		}
+	}
+
+	if _, err := l.parse(); err != nil {
+		return Locale{}, err
+	}
+
+	return l, nil
+}
@@ -0,0 +1,257 @@
+package monies_test
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Craftserve/monies"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openFixture(t *testing.T, name string) *os.File {
+	t.Helper()
+
+	f, err := os.Open("testdata/" + name)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = f.Close() })
+
+	return f
+}
+
+func rowErrors(t *testing.T, err error) map[int]error {
+	t.Helper()
+
+	var errs monies.RowErrors
+	require.True(t, errors.As(err, &errs), "expected RowErrors, got %v", err)
+
+	rows := make(map[int]error, len(errs))
+	for _, e := range errs {
+		rows[e.Row] = e.Err
+	}
+
+	return rows
+}
+
+func TestReadCurrencies(t *testing.T) {
+	expected := []monies.Currency{
+		{Code: monies.PLN, NumericCode: "985", Fraction: 2, Grapheme: "zł", Pattern: "#,##0.00 ¤", Decimal: ",", Thousand: " ",
+			Name: "Polish Złoty", MinorUnitName: "grosz", Countries: monies.NewCountryList("PL")},
+		{Code: monies.CHF, NumericCode: "756", Fraction: 2, Grapheme: "CHF", Pattern: "#,##0.00 ¤", Decimal: ".", Thousand: "'", CashRounding: 5,
+			Name: "Swiss Franc", MinorUnitName: "centime", Countries: monies.NewCountryList("CH", "LI")},
+		{Code: "XCR", Fraction: 0, Grapheme: "CR", Pattern: "#,##0 ¤", Decimal: "."},
+		{Code: monies.ZWD, NumericCode: "716", Fraction: 2, Grapheme: "Z$", Pattern: "¤#,##0.00", Decimal: ".", Thousand: ",",
+			Status: monies.CurrencyWithdrawn, Introduced: time.Date(1980, 4, 18, 0, 0, 0, 0, time.UTC),
+			Withdrawn: time.Date(2009, 2, 2, 0, 0, 0, 0, time.UTC), ReplacedBy: "ZWL"},
+	}
+
+	testCases := []struct {
+		Name string
+		Read func(t *testing.T) ([]monies.Currency, error)
+	}{
+		{"JSON", func(t *testing.T) ([]monies.Currency, error) {
+			return monies.ReadCurrenciesJSON(openFixture(t, "currencies.json"))
+		}},
+		{"CSV", func(t *testing.T) ([]monies.Currency, error) {
+			return monies.ReadCurrenciesCSV(openFixture(t, "currencies.csv"))
+		}},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Name, func(t *testing.T) {
+			cs, err := tC.Read(t)
+			require.NoError(t, err)
+			assert.Equal(t, expected, cs)
+
+			r, err := monies.NewRegistry(cs...)
+			require.NoError(t, err)
+			m, err := r.New(123456, monies.PLN)
+			require.NoError(t, err)
+			assert.Equal(t, "1 234,56 zł", m.String())
+		})
+	}
+}
+
+func TestReadCurrenciesJSONErrors(t *testing.T) {
+	_, err := monies.ReadCurrenciesJSON(openFixture(t, "currencies_invalid.json"))
+	rows := rowErrors(t, err)
+
+	assert.Len(t, rows, 6)
+	assert.NotContains(t, rows, 1)
+	assert.ErrorIs(t, rows[2], monies.ErrInvalidCurrency)
+	assert.Contains(t, rows[2].Error(), "numeric code")
+	assert.ErrorIs(t, rows[3], monies.ErrInvalidCurrency)
+	assert.Contains(t, rows[3].Error(), "fraction 20")
+	assert.Contains(t, rows[4].Error(), "symbol")
+	assert.ErrorIs(t, rows[5], monies.ErrCurrencyExists)
+	assert.ErrorIs(t, rows[6], monies.ErrCurrencyExists)
+	assert.Contains(t, rows[7].Error(), "both pattern and template")
+	assert.ErrorIs(t, err, monies.ErrCurrencyExists)
+
+	_, err = monies.ReadCurrenciesJSON(strings.NewReader(`{"code": "PLN"}`))
+	assert.Error(t, err)
+}
+
+func TestReadCurrenciesCSVErrors(t *testing.T) {
+	_, err := monies.ReadCurrenciesCSV(openFixture(t, "currencies_invalid.csv"))
+	rows := rowErrors(t, err)
+
+	assert.Len(t, rows, 3)
+	assert.Contains(t, rows[3].Error(), "column fraction")
+	assert.Contains(t, rows[4].Error(), `pattern "¤" has no digits`)
+	assert.Contains(t, rows[5].Error(), "upper-case")
+	assert.Contains(t, err.Error(), "row 3 (XBA)")
+
+	testCases := []struct {
+		Name  string
+		Input string
+	}{
+		{"UNKNOWN_COLUMN", "code,fraction,template,decimal,symbol\n"},
+		{"DUPLICATE_COLUMN", "code,fraction,template,decimal,code\n"},
+		{"MISSING_COLUMN", "code,fraction,template\n"},
+		{"MISSING_PATTERN", "code,fraction,decimal\n"},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Name, func(t *testing.T) {
+			_, err := monies.ReadCurrenciesCSV(strings.NewReader(tC.Input))
+			var rowErr *monies.RowError
+			require.True(t, errors.As(err, &rowErr))
+			assert.Equal(t, 1, rowErr.Row)
+			assert.ErrorIs(t, err, monies.ErrInvalidCurrency)
+		})
+	}
+}
+
+func TestReadCurrenciesCSVFieldCount(t *testing.T) {
+	_, err := monies.ReadCurrenciesCSV(strings.NewReader("code,fraction,pattern,decimal\n" +
+		"XAA,2,¤#,##0.00,.\n" +
+		"XAB,2\n" +
+		"XAC,2,\"¤#,##0.00\",.\n" +
+		"XAD,2,\"¤#,##0.00\",.,,\n"))
+	rows := rowErrors(t, err)
+
+	assert.Len(t, rows, 3)
+	assert.Contains(t, rows[2].Error(), "5 fields, want 4")
+	assert.Contains(t, rows[3].Error(), "2 fields, want 4")
+	assert.Contains(t, rows[5].Error(), "6 fields, want 4")
+	assert.Contains(t, err.Error(), "row 3 (XAB)")
+	for _, e := range rows {
+		assert.ErrorIs(t, e, monies.ErrInvalidCurrency)
+	}
+}
+
+func TestReadCurrenciesValidity(t *testing.T) {
+	const header = "code,fraction,template,decimal,status,introduced,withdrawn,replaced_by\n"
+	_, err := monies.ReadCurrenciesCSV(strings.NewReader(header +
+		"XAA,2,1 $,.,retired,,,\n" +
+		"XAB,2,1 $,.,,2009-02-30,,\n" +
+		"XAC,2,1 $,.,withdrawn,2009-02-02,1980-04-18,\n" +
+		"XAD,2,1 $,.,withdrawn,,,XAD\n" +
+		"XAE,2,1 $,.,withdrawn,,2009-02-02,XAA\n"))
+	rows := rowErrors(t, err)
+
+	assert.Len(t, rows, 4)
+	assert.Contains(t, rows[2].Error(), `unknown status "retired"`)
+	assert.Contains(t, rows[3].Error(), "introduced")
+	assert.Contains(t, rows[4].Error(), "withdrawn 1980-04-18 before introduced 2009-02-02")
+	assert.Contains(t, rows[5].Error(), "replaced by itself")
+	for _, e := range rows {
+		assert.ErrorIs(t, e, monies.ErrInvalidCurrency)
+	}
+}
+
+func TestReadISO4217XML(t *testing.T) {
+	cs, err := monies.DefaultRegistry().ReadISO4217XML(openFixture(t, "iso4217.xml"))
+	require.NoError(t, err)
+
+	codes := make([]monies.CurrencyCode, len(cs))
+	for i, c := range cs {
+		codes[i] = c.Code
+	}
+	assert.Equal(t, []monies.CurrencyCode{monies.EUR, monies.PLN, "SLE", "USN", monies.XAU}, codes)
+
+	euro, err := monies.CurrencyByCode(monies.EUR)
+	require.NoError(t, err)
+	assert.Equal(t, euro, cs[0])
+	assert.Equal(t, monies.Currency{Code: "USN", NumericCode: "997", Fraction: 2, Grapheme: "USN", Pattern: "#,##0.00 ¤", Decimal: ".", Thousand: ",", Name: "US Dollar (Next day)"}, cs[3])
+	assert.Equal(t, 0, cs[4].Fraction)
+
+	r, err := monies.NewRegistry(monies.DefaultRegistry().List()...)
+	require.NoError(t, err)
+	require.NoError(t, r.Update(cs...))
+
+	m, err := r.New(150, "USN")
+	require.NoError(t, err)
+	assert.Equal(t, "1.50 USN", m.String())
+
+	_, err = monies.New(150, "USN")
+	assert.ErrorIs(t, err, monies.ErrCurrencyNotFound)
+}
+
+func TestReadISO4217XMLErrors(t *testing.T) {
+	_, err := monies.DefaultRegistry().ReadISO4217XML(openFixture(t, "iso4217_invalid.xml"))
+	rows := rowErrors(t, err)
+
+	assert.Len(t, rows, 2)
+	assert.Contains(t, rows[2].Error(), "BELGIUM")
+	assert.Contains(t, rows[3].Error(), "minor units")
+}
+
+func TestReadLocaleCLDR(t *testing.T) {
+	l, err := monies.ReadLocaleCLDR(openFixture(t, "cldr/sv/numbers.json"), openFixture(t, "cldr/sv/currencies.json"))
+	require.NoError(t, err)
+
+	assert.Equal(t, monies.Locale{
+		Tag:     "sv",
+		Decimal: ",",
+		Group:   "\u00a0",
+		Minus:   "\u2212",
+		Pattern: "#,##0.00\u00a0¤",
+		Symbols: map[monies.CurrencyCode]string{monies.EUR: "€", monies.NOK: "Nkr", monies.SEK: "kr", monies.USD: "US$"},
+	}, l)
+
+	s, err := l.Format(monies.MustNew(-123456, monies.SEK))
+	require.NoError(t, err)
+	assert.Equal(t, "\u22121\u00a0234,56\u00a0kr", s)
+
+	l, err = monies.ReadLocaleCLDR(openFixture(t, "cldr/sv/numbers.json"), nil)
+	require.NoError(t, err)
+	assert.Nil(t, l.Symbols)
+
+	_, err = monies.ReadLocaleCLDR(openFixture(t, "cldr/sv/numbers.json"), openFixture(t, "cldr/de/currencies.json"))
+	assert.ErrorIs(t, err, monies.ErrInvalidLocale)
+
+	_, err = monies.ReadLocaleCLDR(openFixture(t, "cldr/sv/currencies.json"), nil)
+	assert.ErrorIs(t, err, monies.ErrInvalidLocale)
+
+	_, err = monies.ReadLocaleCLDR(strings.NewReader(`{"main": {}}`), nil)
+	assert.ErrorIs(t, err, monies.ErrInvalidLocale)
+}
+
+func TestRegistryUpdate(t *testing.T) {
+	r, err := monies.NewRegistry(monies.DefaultRegistry().List()...)
+	require.NoError(t, err)
+
+	pln, err := r.ByCode(monies.PLN)
+	require.NoError(t, err)
+	pln.Thousand = " "
+	credits := monies.Currency{Code: "XCR", Fraction: 0, Grapheme: "CR", Pattern: "#,##0 ¤", Decimal: "."}
+	require.NoError(t, r.Update(pln, credits))
+
+	c, err := r.ByCode(monies.PLN)
+	require.NoError(t, err)
+	assert.Equal(t, " ", c.Thousand)
+	_, err = r.ByCode("XCR")
+	assert.NoError(t, err)
+
+	clash := monies.Currency{Code: "XCL", NumericCode: "985", Fraction: 0, Pattern: "#,##0 ¤", Decimal: "."}
+	assert.ErrorIs(t, r.Update(clash, monies.Currency{Code: "XCM", Pattern: "#,##0.00 ¤", Decimal: "."}), monies.ErrCurrencyExists)
+	_, err = r.ByCode("XCM")
+	assert.ErrorIs(t, err, monies.ErrCurrencyNotFound)
+
+	assert.ErrorIs(t, r.Update(monies.Currency{Code: "bad"}), monies.ErrInvalidCurrency)
+}
@@ -0,0 +1,277 @@
+package monies
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrLocaleNotFound = errors.New("locale not found")
+	ErrInvalidLocale  = errors.New("invalid locale")
+)
+
+// Locale holds the conventions of a language and region for writing amounts
+// of money, such as separators and the position of the currency symbol. It
+// applies to amounts in any currency: the same Money reads "1 234,56 zł" in
+// pl-PL and "PLN 1,234.56" in en-US.
+type Locale struct {
+	// Tag is the BCP 47 language tag of the locale, e.g. "pl-PL".
+	Tag     string
+	Decimal string
+	Group   string
+	// Minus is the minus sign. An empty Minus stands for "-".
+	Minus string
+	// Pattern is the CLDR currency pattern of the locale, e.g. "¤#,##0.00"
+	// or "#,##0.00 ¤". The ¤ sign stands for the currency symbol and the ","
+	// signs give the grouping sizes, while the number of fraction digits is
+	// always that of the currency. A negative subpattern may follow a ";",
+	// otherwise negative amounts are prefixed with the minus sign.
+	Pattern string
+	// Symbols maps currency codes to their symbols in the locale. Currencies
+	// without a symbol are shown by code.
+	Symbols map[CurrencyCode]string
+}
+
+// currencySpacing separates a symbol ending or starting with a letter from
+// the digits, as in "CHF 12.00", when the pattern puts them side by side.
+const currencySpacing = "\u00a0"
+
+// builtinLocales are the locales known to FormatLocale from the start, as
+// defined by CLDR. Like CLDR, they use no-break spaces rather than spaces.
+var builtinLocales = []Locale{
+	{Tag: "de-AT", Decimal: ",", Group: "\u00a0", Pattern: "¤\u00a0#,##0.00",
+		Symbols: map[CurrencyCode]string{EUR: "€", USD: "$", GBP: "£", JPY: "¥", CHF: "CHF"}},
+	{Tag: "de-CH", Decimal: ".", Group: "’", Pattern: "¤\u00a0#,##0.00;¤-#,##0.00",
+		Symbols: map[CurrencyCode]string{CHF: "CHF", EUR: "€", USD: "$", GBP: "£", JPY: "¥"}},
+	{Tag: "de-DE", Decimal: ",", Group: ".", Pattern: "#,##0.00\u00a0¤",
+		Symbols: map[CurrencyCode]string{EUR: "€", USD: "$", GBP: "£", JPY: "¥", CHF: "CHF"}},
+	{Tag: "en-GB", Decimal: ".", Group: ",", Pattern: "¤#,##0.00",
+		Symbols: map[CurrencyCode]string{GBP: "£", EUR: "€", USD: "US$", JPY: "JP¥", INR: "₹"}},
+	{Tag: "en-IE", Decimal: ".", Group: ",", Pattern: "¤#,##0.00",
+		Symbols: map[CurrencyCode]string{EUR: "€", GBP: "£", USD: "US$", JPY: "JP¥"}},
+	{Tag: "en-IN", Decimal: ".", Group: ",", Pattern: "¤#,##,##0.00",
+		Symbols: map[CurrencyCode]string{INR: "₹", USD: "$", EUR: "€", GBP: "£", JPY: "JP¥"}},
+	{Tag: "en-US", Decimal: ".", Group: ",", Pattern: "¤#,##0.00",
+		Symbols: map[CurrencyCode]string{USD: "$", EUR: "€", GBP: "£", JPY: "¥", CAD: "CA$", INR: "₹"}},
+	{Tag: "es-ES", Decimal: ",", Group: ".", Pattern: "#,##0.00\u00a0¤",
+		Symbols: map[CurrencyCode]string{EUR: "€", USD: "US$", GBP: "GBP", JPY: "JPY"}},
+	{Tag: "fr-CH", Decimal: ",", Group: "\u202f", Pattern: "#,##0.00\u00a0¤;-#,##0.00\u00a0¤",
+		Symbols: map[CurrencyCode]string{CHF: "CHF", EUR: "€", USD: "$US", GBP: "£GB", JPY: "JPY"}},
+	{Tag: "fr-FR", Decimal: ",", Group: "\u202f", Pattern: "#,##0.00\u00a0¤",
+		Symbols: map[CurrencyCode]string{EUR: "€", USD: "$US", GBP: "£GB", JPY: "JPY", CHF: "CHF"}},
+	{Tag: "it-IT", Decimal: ",", Group: ".", Pattern: "#,##0.00\u00a0¤",
+		Symbols: map[CurrencyCode]string{EUR: "€", USD: "USD", GBP: "£", JPY: "JPY"}},
+	{Tag: "ja-JP", Decimal: ".", Group: ",", Pattern: "¤#,##0.00",
+		Symbols: map[CurrencyCode]string{JPY: "￥", USD: "$", EUR: "€", GBP: "£"}},
+	{Tag: "nl-NL", Decimal: ",", Group: ".", Pattern: "¤\u00a0#,##0.00;¤\u00a0-#,##0.00",
+		Symbols: map[CurrencyCode]string{EUR: "€", USD: "US$", GBP: "£", JPY: "JP¥"}},
+	{Tag: "pl-PL", Decimal: ",", Group: "\u00a0", Pattern: "#,##0.00\u00a0¤",
+		Symbols: map[CurrencyCode]string{PLN: "zł", EUR: "€", USD: "USD", GBP: "GBP", JPY: "JPY"}},
+}
+
+// localeFormat is a registered locale along with its parsed pattern.
+type localeFormat struct {
+	locale  Locale
+	pattern numberPattern
+}
+
+var (
+	localesMu sync.RWMutex
+	locales   map[string]*localeFormat
+	// localesOnce parses builtinLocales into locales on first use, so that
+	// importing the package does no work and cannot fail.
+	localesOnce sync.Once
+	localesErr  error
+)
+
+// loadLocales registers builtinLocales unless it has been done already.
+func loadLocales() error {
+	localesOnce.Do(func() {
+		loaded := make(map[string]*localeFormat, len(builtinLocales))
+		for _, l := range builtinLocales {
+			f, err := newLocaleFormat(l)
+			if err != nil {
+				localesErr = err
+				return
+			}
+			loaded[canonicalTag(l.Tag)] = f
+		}
+
+		localesMu.Lock()
+		defer localesMu.Unlock()
+
+		locales = loaded
+	})
+
+	return localesErr
+}
+
+// newLocaleFormat validates l and parses its pattern. The symbols of l are
+// copied, so that later changes to the map do not affect the result.
+func newLocaleFormat(l Locale) (*localeFormat, error) {
+	p, err := l.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	symbols := make(map[CurrencyCode]string, len(l.Symbols))
+	for code, s := range l.Symbols {
+		symbols[code] = s
+	}
+	l.Symbols = symbols
+
+	return &localeFormat{locale: l, pattern: p}, nil
+}
+
+// RegisterLocale makes l known to FormatLocale and LocaleByTag, replacing
+// any locale with the same tag.
+func RegisterLocale(l Locale) error {
+	if err := loadLocales(); err != nil {
+		return err
+	}
+
+	f, err := newLocaleFormat(l)
+	if err != nil {
+		return err
+	}
+
+	localesMu.Lock()
+	defer localesMu.Unlock()
+
+	locales[canonicalTag(l.Tag)] = f
+	return nil
+}
+
+// UnregisterLocale removes the locale with the given tag, matched like in
+// LocaleByTag.
+func UnregisterLocale(tag string) error {
+	if err := loadLocales(); err != nil {
+		return err
+	}
+
+	localesMu.Lock()
+	defer localesMu.Unlock()
+
+	key := canonicalTag(tag)
+	if _, ok := locales[key]; !ok {
+		return fmt.Errorf("%w: %s", ErrLocaleNotFound, tag)
+	}
+
+	delete(locales, key)
+	return nil
+}
+
+// LocaleByTag returns the registered locale with the given tag. Tags are
+// matched regardless of case and of "-" or "_" separators.
+func LocaleByTag(tag string) (Locale, error) {
+	f, err := localeByTag(tag)
+	if err != nil {
+		return Locale{}, err
+	}
+
+	return f.locale, nil
+}
+
+func localeByTag(tag string) (*localeFormat, error) {
+	if err := loadLocales(); err != nil {
+		return nil, err
+	}
+
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	f, ok := locales[canonicalTag(tag)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrLocaleNotFound, tag)
+	}
+
+	return f, nil
+}
+
+// FormatLocale renders m following the conventions of the registered locale
+// with the given tag.
+func FormatLocale(m Money, tag string) (string, error) {
+	f, err := localeByTag(tag)
+	if err != nil {
+		return "", err
+	}
+
+	var buf [64]byte
+	return string(f.locale.appendMoney(buf[:0], m, &f.pattern)), nil
+}
+
+// Format renders m following the conventions of l.
+func (l Locale) Format(m Money) (string, error) {
+	p, err := l.parse()
+	if err != nil {
+		return "", err
+	}
+
+	return string(l.appendMoney(nil, m, &p)), nil
+}
+
+// parse validates l and returns its parsed pattern.
+func (l Locale) parse() (numberPattern, error) {
+	if l.Tag == "" {
+		return numberPattern{}, fmt.Errorf("%w: tag is required", ErrInvalidLocale)
+	}
+	if l.Decimal == "" {
+		return numberPattern{}, fmt.Errorf("%w: %s: decimal separator is required", ErrInvalidLocale, l.Tag)
+	}
+
+	p, err := parsePattern(l.Pattern)
+	if err != nil {
+		return numberPattern{}, fmt.Errorf("%w: %s: %v", ErrInvalidLocale, l.Tag, err)
+	}
+	if !strings.Contains(p.posPrefix+p.posSuffix, "¤") {
+		return numberPattern{}, fmt.Errorf("%w: %s: pattern %q has no currency sign", ErrInvalidLocale, l.Tag, l.Pattern)
+	}
+
+	return p, nil
+}
+
+func (l *Locale) appendMoney(dst []byte, m Money, p *numberPattern) []byte {
+	c := m.cur()
+	symbol, ok := l.Symbols[c.Code]
+	if !ok {
+		symbol = string(c.Code)
+	}
+
+	minus := l.Minus
+	if minus == "" {
+		minus = "-"
+	}
+
+	var buf [20]byte
+	return p.append(dst, strconv.AppendUint(buf[:0], magnitude(m.amount), 10), m.amount < 0, c.Fraction, patternSymbols{
+		decimal:  l.Decimal,
+		group:    l.Group,
+		minus:    minus,
+		currency: symbol,
+		spacing:  currencySpacing,
+	})
+}
+
+// canonicalTag returns tag with "-" separators, the language in lower case,
+// the script in title case and the region in upper case: "pl_pl" gives
+// "pl-PL".
+func canonicalTag(tag string) string {
+	parts := strings.FieldsFunc(tag, func(r rune) bool { return r == '-' || r == '_' })
+	for i, part := range parts {
+		switch {
+		case i == 0:
+			parts[i] = strings.ToLower(part)
+		case len(part) == 2:
+			parts[i] = strings.ToUpper(part)
+		case len(part) == 4:
+			parts[i] = strings.ToUpper(part[:1]) + strings.ToLower(part[1:])
+		default:
+			parts[i] = strings.ToLower(part)
+		}
+	}
+
+	return strings.Join(parts, "-")
+}
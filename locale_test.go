@@ -0,0 +1,112 @@
+package monies_test
+
+import (
+	"testing"
+
+	"github.com/Craftserve/monies"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatLocale(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		m        monies.Money
+		tag      string
+		expected string
+	}{
+		{"pl-PL", monies.MustNew(123456, monies.PLN), "pl-PL", "1\u00a0234,56\u00a0zł"},
+		{"en-US", monies.MustNew(123456, monies.PLN), "en-US", "PLN\u00a01,234.56"},
+		{"de-DE", monies.MustNew(123456, monies.EUR), "de-DE", "1.234,56\u00a0€"},
+		{"en-IE", monies.MustNew(123456, monies.EUR), "en-IE", "€1,234.56"},
+		{"pl-PL EUR", monies.MustNew(123456, monies.EUR), "pl-PL", "1\u00a0234,56\u00a0€"},
+		{"fr-CH", monies.MustNew(123456, monies.CHF), "fr-CH", "1\u202f234,56\u00a0CHF"},
+		{"de-CH negative", monies.MustNew(-123456, monies.CHF), "de-CH", "CHF-1’234.56"},
+		{"en-US negative", monies.MustNew(-123456, monies.USD), "en-US", "-$1,234.56"},
+		{"nl-NL negative", monies.MustNew(-123456, monies.EUR), "nl-NL", "€\u00a0-1.234,56"},
+		{"en-IN", monies.MustNew(1234567890, monies.INR), "en-IN", "₹1,23,45,678.90"},
+		{"ja-JP", monies.MustNew(1234567, monies.JPY), "ja-JP", "￥1,234,567"},
+		{"three digit fraction", monies.MustNew(5, monies.KWD), "en-US", "KWD\u00a00.005"},
+		{"underscore tag", monies.MustNew(100, monies.PLN), "pl_pl", "1,00\u00a0zł"},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Name, func(t *testing.T) {
+			s, err := monies.FormatLocale(tC.m, tC.tag)
+			require.NoError(t, err)
+			assert.Equal(t, tC.expected, s)
+		})
+	}
+
+	_, err := monies.FormatLocale(monies.MustNew(100, monies.PLN), "xx-XX")
+	assert.ErrorIs(t, err, monies.ErrLocaleNotFound)
+}
+
+func TestBuiltinLocales(t *testing.T) {
+	tags := []string{"de-AT", "de-CH", "de-DE", "en-GB", "en-IE", "en-IN", "en-US",
+		"es-ES", "fr-CH", "fr-FR", "it-IT", "ja-JP", "nl-NL", "pl-PL"}
+
+	for _, tag := range tags {
+		l, err := monies.LocaleByTag(tag)
+		require.NoError(t, err, tag)
+		assert.Equal(t, tag, l.Tag)
+
+		_, err = l.Format(monies.MustNew(-123456, monies.EUR))
+		assert.NoError(t, err, tag)
+	}
+}
+
+func TestLocaleFormat(t *testing.T) {
+	l := monies.Locale{Tag: "en-x-accounting", Decimal: ".", Group: ",", Pattern: "'['¤']' #,##0.00;(¤ #,##0.00)"}
+
+	s, err := l.Format(monies.MustNew(-123456, monies.USD))
+	require.NoError(t, err)
+	assert.Equal(t, "(USD 1,234.56)", s)
+
+	s, err = l.Format(monies.MustNew(123456, monies.USD))
+	require.NoError(t, err)
+	assert.Equal(t, "[USD] 1,234.56", s)
+}
+
+func TestRegisterLocale(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		locale monies.Locale
+	}{
+		{"NO_TAG", monies.Locale{Decimal: ".", Pattern: "¤#,##0.00"}},
+		{"NO_DECIMAL", monies.Locale{Tag: "en-ZZ", Pattern: "¤#,##0.00"}},
+		{"NO_DIGITS", monies.Locale{Tag: "en-ZZ", Decimal: ".", Pattern: "¤"}},
+		{"NO_CURRENCY_SIGN", monies.Locale{Tag: "en-ZZ", Decimal: ".", Pattern: "#,##0.00"}},
+		{"EMPTY_GROUP", monies.Locale{Tag: "en-ZZ", Decimal: ".", Pattern: "¤#,##0,.00"}},
+		{"INVALID_NUMBER", monies.Locale{Tag: "en-ZZ", Decimal: ".", Pattern: "¤# ##0.00"}},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Name, func(t *testing.T) {
+			assert.ErrorIs(t, monies.RegisterLocale(tC.locale), monies.ErrInvalidLocale)
+		})
+	}
+
+	symbols := map[monies.CurrencyCode]string{monies.EUR: "€"}
+	require.NoError(t, monies.RegisterLocale(monies.Locale{Tag: "en-ZZ", Decimal: ".", Group: " ", Pattern: "#,##0.00 ¤", Symbols: symbols}))
+	t.Cleanup(func() { _ = monies.UnregisterLocale("en-ZZ") })
+	symbols[monies.EUR] = "EURO"
+
+	l, err := monies.LocaleByTag("EN_zz")
+	require.NoError(t, err)
+	assert.Equal(t, "en-ZZ", l.Tag)
+
+	s, err := monies.FormatLocale(monies.MustNew(123456, monies.EUR), "en-ZZ")
+	require.NoError(t, err)
+	assert.Equal(t, "1 234.56 €", s)
+}
+
+func TestUnregisterLocale(t *testing.T) {
+	require.NoError(t, monies.RegisterLocale(monies.Locale{Tag: "en-ZY", Decimal: ".", Pattern: "¤#,##0.00"}))
+
+	require.NoError(t, monies.UnregisterLocale("en_zy"))
+	assert.ErrorIs(t, monies.UnregisterLocale("en-ZY"), monies.ErrLocaleNotFound)
+
+	_, err := monies.LocaleByTag("en-ZY")
+	assert.ErrorIs(t, err, monies.ErrLocaleNotFound)
+}
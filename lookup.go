@@ -0,0 +1,99 @@
+package monies
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// LookupCurrency resolves s in the default registry. See Registry.Lookup.
+func LookupCurrency(s, hint string) ([]Currency, error) {
+	return defaultRegistry.Lookup(s, hint)
+}
+
+// LookupCurrencyAt resolves s in the default registry as of t. See
+// Registry.LookupAt.
+func LookupCurrencyAt(s, hint string, t time.Time) ([]Currency, error) {
+	return defaultRegistry.LookupAt(s, hint, t)
+}
+
+// Lookup resolves free-form input to the currencies it may stand for, best
+// match first. s may be a currency code in any case ("pln"), a numeric code
+// ("985") or a grapheme ("zł", "R$"). A code or numeric code gives a single
+// currency, while a grapheme shared by several currencies, such as "$",
+// gives all of them ranked: currencies in use today before the others,
+// then those used in more countries. See LookupAt for ranking as of another
+// time.
+//
+// hint is an optional ISO 3166-1 alpha-2 country code in any case ("CA",
+// "ca") or a locale with a region ("en-CA", "fr_CA"). When some of the
+// candidates are used in the hinted country, only those are returned.
+func (r *Registry) Lookup(s, hint string) ([]Currency, error) {
+	return r.LookupAt(s, hint, time.Now())
+}
+
+// LookupAt works like Lookup, but ranks the currencies in use at t first.
+func (r *Registry) LookupAt(s, hint string, t time.Time) ([]Currency, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, ErrCurrencyNotFound
+	}
+
+	if c, err := r.ByCode(CurrencyCode(strings.ToUpper(s))); err == nil {
+		return []Currency{c}, nil
+	}
+
+	if len(s) == 3 && strings.Trim(s, "0123456789") == "" {
+		c, err := r.ByNumericCode(s)
+		if err != nil {
+			return nil, err
+		}
+		return []Currency{c}, nil
+	}
+
+	cs := r.Filter(func(c Currency) bool {
+		return strings.EqualFold(c.Grapheme, s)
+	})
+
+	if len(cs) == 0 {
+		return nil, ErrCurrencyNotFound
+	}
+
+	if country := hintCountry(hint); country != "" {
+		var local []Currency
+		for _, c := range cs {
+			if c.Countries.Contains(country) {
+				local = append(local, c)
+			}
+		}
+		if len(local) > 0 {
+			cs = local
+		}
+	}
+
+	sort.SliceStable(cs, func(i, j int) bool {
+		iu, ju := cs[i].InUseAt(t), cs[j].InUseAt(t)
+		if iu != ju {
+			return iu
+		}
+
+		return cs[i].Countries.Len() > cs[j].Countries.Len()
+	})
+
+	return cs, nil
+}
+
+// hintCountry extracts the country code from a country or locale hint, or
+// returns "" when the hint names none.
+func hintCountry(hint string) string {
+	if i := strings.LastIndexAny(hint, "-_"); i >= 0 {
+		hint = hint[i+1:]
+	}
+	hint = strings.ToUpper(hint)
+
+	if len(hint) != 2 || strings.Trim(hint, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		return ""
+	}
+
+	return hint
+}
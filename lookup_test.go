@@ -0,0 +1,85 @@
+package monies_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Craftserve/monies"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupCurrency(t *testing.T) {
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		Name     string
+		Input    string
+		Hint     string
+		Expected []monies.CurrencyCode
+	}{
+		{"CODE", "PLN", "", []monies.CurrencyCode{monies.PLN}},
+		{"LOWER_CASE_CODE", " pln ", "", []monies.CurrencyCode{monies.PLN}},
+		{"NUMERIC_CODE", "985", "", []monies.CurrencyCode{monies.PLN}},
+		{"GRAPHEME", "zł", "", []monies.CurrencyCode{monies.PLN}},
+		{"EURO", "€", "", []monies.CurrencyCode{monies.EUR}},
+		{"REAL", "R$", "", []monies.CurrencyCode{monies.BRL}},
+		{"GRAPHEME_CASE", "ZŁ", "", []monies.CurrencyCode{monies.PLN}},
+		{"COUNTRY_HINT", "$", "CA", []monies.CurrencyCode{monies.CAD}},
+		{"LOWER_CASE_COUNTRY_HINT", "$", "ca", []monies.CurrencyCode{monies.CAD}},
+		{"LOWER_CASE_COUNTRY_HINT_KR", "kr", "is", []monies.CurrencyCode{monies.ISK}},
+		{"LOCALE_HINT", "$", "es_MX", []monies.CurrencyCode{monies.MXN}},
+		{"SHARED_HINT", "$", "en-PA", []monies.CurrencyCode{monies.USD}},
+		{"WITHDRAWN_LAST", "₽", "RU", []monies.CurrencyCode{monies.RUB, monies.RUR}},
+		{"UNMATCHED_HINT", "zł", "DE", []monies.CurrencyCode{monies.PLN}},
+		{"WITHDRAWN_SINCE", "Z$", "", []monies.CurrencyCode{monies.ZWD, monies.ZWL}},
+		{"UNMATCHED_LOWER_CASE_HINT", "kr", "sv", []monies.CurrencyCode{monies.DKK, monies.NOK, monies.ISK, monies.SEK, monies.EEK}},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Name, func(t *testing.T) {
+			cs, err := monies.LookupCurrencyAt(tC.Input, tC.Hint, at)
+			require.NoError(t, err)
+
+			codes := make([]monies.CurrencyCode, len(cs))
+			for i, c := range cs {
+				codes[i] = c.Code
+			}
+			assert.Equal(t, tC.Expected, codes)
+		})
+	}
+}
+
+func TestLookupCurrencyAmbiguous(t *testing.T) {
+	cs, err := monies.LookupCurrency("$", "")
+	require.NoError(t, err)
+	require.Greater(t, len(cs), 10)
+	assert.Equal(t, monies.USD, cs[0].Code)
+	for _, c := range cs {
+		assert.Equal(t, "$", c.Grapheme)
+	}
+}
+
+func TestLookupCurrencyAt(t *testing.T) {
+	codes := func(cs []monies.Currency, err error) []monies.CurrencyCode {
+		require.NoError(t, err)
+		var result []monies.CurrencyCode
+		for _, c := range cs {
+			result = append(result, c.Code)
+		}
+		return result
+	}
+
+	assert.Equal(t, []monies.CurrencyCode{monies.ZWD, monies.ZWL},
+		codes(monies.LookupCurrencyAt("Z$", "", time.Date(2005, 1, 1, 0, 0, 0, 0, time.UTC))))
+	assert.Equal(t, []monies.CurrencyCode{monies.ZWL, monies.ZWD},
+		codes(monies.LookupCurrencyAt("Z$", "", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))))
+	assert.Equal(t, []monies.CurrencyCode{monies.RUR, monies.RUB},
+		codes(monies.LookupCurrencyAt("₽", "RU", time.Date(1995, 1, 1, 0, 0, 0, 0, time.UTC))))
+}
+
+func TestLookupCurrencyNotFound(t *testing.T) {
+	for _, input := range []string{"", "XYZ", "999", "¤"} {
+		_, err := monies.LookupCurrency(input, "")
+		assert.ErrorIs(t, err, monies.ErrCurrencyNotFound, input)
+	}
+}
@@ -1,15 +1,15 @@
 package monies
 
-import "math"
+import (
+	"math"
+	"math/big"
+	"math/bits"
+)
 
 func modulus(a int64, d int64) int64 {
 	return a % d
 }
 
-func allocate(a int64, r, s int) int64 {
-	return a * int64(r) / int64(s)
-}
-
 func absolute(a int64) int64 {
 	if a < 0 {
 		return -a
@@ -18,26 +18,155 @@ func absolute(a int64) int64 {
 	return a
 }
 
-func round(a int64, e int) int64 {
-	if a == 0 {
-		return 0
+// magnitude returns the absolute value of a as uint64, which unlike
+// absolute is correct for math.MinInt64.
+func magnitude(a int64) uint64 {
+	if a < 0 {
+		return -uint64(a)
 	}
 
-	absam := absolute(a)
-	exp := int64(math.Pow(10, float64(e)))
-	m := absam % exp
+	return uint64(a)
+}
 
-	if m > (exp / 2) {
-		absam += exp
+// signed converts magnitude u back to int64 with the given sign. It reports
+// false when the result does not fit into int64.
+func signed(u uint64, neg bool) (int64, bool) {
+	if neg {
+		if u > 1<<63 {
+			return 0, false
+		}
+		return -int64(u), true
 	}
 
-	absam = (absam / exp) * exp
+	if u > math.MaxInt64 {
+		return 0, false
+	}
+	return int64(u), true
+}
 
-	if a < 0 {
-		a = -absam
-	} else {
-		a = absam
+func checkedAdd(a, b int64) (int64, bool) {
+	c := a + b
+	if (c > a) != (b > 0) {
+		return c, false
 	}
 
-	return a
+	return c, true
+}
+
+func checkedSub(a, b int64) (int64, bool) {
+	c := a - b
+	if (c < a) != (b > 0) {
+		return c, false
+	}
+
+	return c, true
+}
+
+func checkedMul(a, b int64) (int64, bool) {
+	hi, lo := bits.Mul64(magnitude(a), magnitude(b))
+	if hi != 0 {
+		return 0, false
+	}
+
+	return signed(lo, (a < 0) != (b < 0))
+}
+
+func checkedAbs(a int64) (int64, bool) {
+	if a == math.MinInt64 {
+		return a, false
+	}
+
+	return absolute(a), true
+}
+
+// saturate returns the int64 bound closest to an overflowed result whose
+// mathematical sign is given by neg.
+func saturate(neg bool) int64 {
+	if neg {
+		return math.MinInt64
+	}
+
+	return math.MaxInt64
+}
+
+// round rounds a to a multiple of 10^e with exact halves rounded towards zero.
+// It reports false when the result does not fit into int64, in which case the
+// multiple next to a towards zero is returned.
+func round(a int64, e int) (int64, bool) {
+	exp, ok := pow10(e)
+	if !ok {
+		return 0, true
+	}
+
+	r, ok := checkedMul(divRound(a, exp, RoundHalfDown), exp)
+	if !ok {
+		return a / exp * exp, false
+	}
+
+	return r, true
+}
+
+// pow10 returns 10^e. It reports false when the power does not fit into int64.
+func pow10(e int) (int64, bool) {
+	if e < 0 || e > 18 {
+		return 0, false
+	}
+
+	p := int64(1)
+	for ; e > 0; e-- {
+		p *= 10
+	}
+
+	return p, true
+}
+
+// divRound returns a/d rounded according to mode. d must be positive.
+func divRound(a, d int64, mode RoundingMode) int64 {
+	q, r := a/d, a%d
+	if r == 0 {
+		return q
+	}
+
+	rem := magnitude(r)
+	if mode.roundsAway(a < 0, compareUint(rem, uint64(d)-rem), q%2 != 0) {
+		if a < 0 {
+			q--
+		} else {
+			q++
+		}
+	}
+
+	return q
+}
+
+// quoRound returns n/d rounded according to mode. d must be positive.
+func quoRound(n, d *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	r.Abs(r).Lsh(r, 1)
+	if mode.roundsAway(n.Sign() < 0, r.Cmp(d), q.Bit(0) == 1) {
+		if n.Sign() < 0 {
+			q.Sub(q, bigOne)
+		} else {
+			q.Add(q, bigOne)
+		}
+	}
+
+	return q
+}
+
+var bigOne = big.NewInt(1)
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
 }
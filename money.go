@@ -1,10 +1,7 @@
 package monies
 
 import (
-	"bytes"
-	"encoding/json"
 	"errors"
-	"fmt"
 	"math"
 	"strconv"
 	"strings"
@@ -15,29 +12,41 @@ var (
 	ErrNegativeSplit    = errors.New("split be must be positive")
 	ErrNoRatios         = errors.New("no ratios provided")
 	ErrInvalidText      = errors.New("invalid text")
+	ErrOverflow         = errors.New("amount overflows int64")
 )
 
 // Money represents a monetary value
+//
+// Money values are equal under == only when they point to the same currency
+// handle, that is when they were created in the same Registry and their
+// currency has not been replaced by Registry.Update since. Use Equals, or
+// SameCurrency, to compare amounts by currency code instead.
 type Money struct {
-	amount   int64
-	currency Currency
+	amount int64
+	// currency points to a currency handle of a Registry, so that copying
+	// Money is cheap. It is nil only for the zero value.
+	currency *Currency
 }
 
-// New creates and returns new instance of Money.
-func New(amount int64, code CurrencyCode) (m Money, err error) {
-	currency, err := CurrencyByCode(code)
-	if err != nil {
-		return m, ErrCurrencyNotFound
+// noCurrency stands for the currency of the zero Money.
+var noCurrency Currency
+
+// cur returns the currency of m, which is never nil.
+func (m Money) cur() *Currency {
+	if m.currency == nil {
+		return &noCurrency
 	}
 
-	return Money{
-		amount:   amount,
-		currency: currency,
-	}, nil
+	return m.currency
+}
+
+// New creates and returns new instance of Money.
+func New(amount int64, code CurrencyCode) (m Money, err error) {
+	return defaultRegistry.New(amount, code)
 }
 
 func (m Money) Currency() Currency {
-	return m.currency
+	return *m.cur()
 }
 
 func (m Money) Amount() int64 {
@@ -45,55 +54,57 @@ func (m Money) Amount() int64 {
 }
 
 func (m Money) String() string {
-	// Work with absolute amount value
-	sa := strconv.FormatInt(absolute(m.amount), 10)
-	c := m.currency
-
-	if len(sa) <= m.currency.Fraction {
-		sa = strings.Repeat("0", c.Fraction-len(sa)+1) + sa
-	}
-
-	if c.Thousand != "" {
-		for i := len(sa) - c.Fraction - 3; i > 0; i -= 3 {
-			sa = sa[:i] + c.Thousand + sa[i:]
-		}
-	}
-
-	if c.Fraction > 0 {
-		sa = sa[:len(sa)-c.Fraction] + c.Decimal + sa[len(sa)-c.Fraction:]
-	}
-	sa = strings.Replace(c.Template, "1", sa, 1)
-	sa = strings.Replace(sa, "$", c.Grapheme, 1)
+	var buf [64]byte
+	return string(m.AppendString(buf[:0]))
+}
 
-	// Add minus sign for negative amount.
-	if m.amount < 0 {
-		sa = "-" + sa
-	}
+// AppendString appends the String form of m to dst and returns the extended
+// buffer. It does not allocate when dst has enough capacity.
+func (m Money) AppendString(dst []byte) []byte {
+	var buf [20]byte
+	return appendAmount(dst, strconv.AppendUint(buf[:0], magnitude(m.amount), 10), m.amount < 0, m.cur())
+}
 
-	return sa
+// appendAmount appends the decimal digits of an absolute amount in minor
+// units, rendered with the pattern and separators of c, to dst.
+func appendAmount(dst, digits []byte, negative bool, c *Currency) []byte {
+	// The patterns of registered currencies are valid, only the zero Money
+	// falls back to the bare digits.
+	p, _ := cachedPattern(c.Pattern)
+	return p.append(dst, digits, negative, c.Fraction, patternSymbols{
+		decimal:  c.Decimal,
+		group:    c.Thousand,
+		minus:    "-",
+		currency: c.Grapheme,
+	})
 }
 
 func (m Money) AsMajorUnits() float64 {
-	if m.currency.Fraction == 0 {
+	if m.cur().Fraction == 0 {
 		return float64(m.amount)
 	}
 
-	return float64(m.amount) / float64(math.Pow10(m.currency.Fraction))
+	return float64(m.amount) / float64(math.Pow10(m.cur().Fraction))
 }
 
-func (m *Money) UnmarshalJSON(b []byte) error {
-	type moneyJSON struct {
-		Currency CurrencyCode `json:"currency"`
-		Amount   int64        `json:"amount"`
-	}
+// moneyJSON is the JSON form of Money.
+type moneyJSON struct {
+	Currency CurrencyCode `json:"currency"`
+	Amount   int64        `json:"amount"`
+}
 
-	var ref moneyJSON
-	err := json.Unmarshal(b, &ref)
+func (m *Money) UnmarshalJSON(b []byte) error {
+	money, err := defaultRegistry.ParseJSON(b)
 	if err != nil {
 		return err
 	}
 
-	money, err := New(ref.Amount, ref.Currency)
+	*m = money
+	return nil
+}
+
+func (m *Money) UnmarshalText(text []byte) error {
+	money, err := defaultRegistry.parseText(text)
 	if err != nil {
 		return err
 	}
@@ -101,62 +112,126 @@ func (m *Money) UnmarshalJSON(b []byte) error {
 	*m = money
 	return nil
 }
-func (m *Money) UnmarshalText(text []byte) error {
-	if len(text) < 5 {
-		return ErrInvalidText
-	}
-
-	currencyCode := strings.TrimSpace(string(text)[len(text)-4:])
-	amountStr := string(text[:len(text)-4])
 
-	currency, err := CurrencyByCode(CurrencyCode(currencyCode))
+// parseText parses the text form of an amount, resolving its currency in r.
+func (r *Registry) parseText(text []byte) (Money, error) {
+	currency, majorUnitsStr, minorUnitsStr, err := r.splitText(text)
 	if err != nil {
-		return err
+		return Money{}, err
 	}
 
-	majorUnitsStr := strings.Split(amountStr, currency.Decimal)[0]
-	minorUnitsStr := strings.Split(amountStr, currency.Decimal)[1]
-
 	majorUnits, err := strconv.ParseInt(majorUnitsStr, 10, 64)
 	if err != nil {
-		return err
+		return Money{}, err
 	}
 
 	minorUnits, err := strconv.ParseInt(minorUnitsStr, 10, 64)
 	if err != nil {
-		return err
+		return Money{}, err
 	}
 
-	var amount = majorUnits*int64(math.Pow10(currency.Fraction)) + minorUnits
+	if strings.HasPrefix(majorUnitsStr, "-") {
+		minorUnits = -minorUnits
+	}
 
-	money, _ := New(amount, CurrencyCode(currencyCode))
+	exp, _ := pow10(currency.Fraction)
+	amount, ok := checkedMul(majorUnits, exp)
+	if ok {
+		amount, ok = checkedAdd(amount, minorUnits)
+	}
+	if !ok {
+		return Money{}, ErrOverflow
+	}
 
-	*m = money
+	return Money{amount: amount, currency: currency}, nil
+}
 
-	return nil
+// splitText splits the text form of an amount, e.g. "1.00 USD", into its
+// currency and the strings holding the major and minor units.
+func (r *Registry) splitText(text []byte) (currency *Currency, major, minor string, err error) {
+	i := strings.LastIndexByte(string(text), ' ')
+	if i < 1 || i == len(text)-1 {
+		return currency, "", "", ErrInvalidText
+	}
+
+	currencyCode, amountStr := string(text[i+1:]), string(text[:i])
+
+	currency, err = r.handle(CurrencyCode(currencyCode))
+	if err != nil {
+		return currency, "", "", err
+	}
+
+	parts := strings.Split(amountStr, currency.Decimal)
+	if len(parts) != 2 {
+		return currency, "", "", ErrInvalidText
+	}
+
+	return currency, parts[0], parts[1], nil
 }
 
 func (m Money) MarshalText() ([]byte, error) {
-	var majorUnits = m.amount / int64(math.Pow10(m.currency.Fraction))
-	var majorUnitsStr = strconv.FormatInt(majorUnits, 10)
+	return m.AppendText(make([]byte, 0, 32)), nil
+}
 
-	var minorUnits = m.amount - (majorUnits * int64(math.Pow10(m.currency.Fraction)))
-	var minorUnitsStr = strconv.FormatInt(minorUnits, 10)
+// AppendText appends the MarshalText form of m to dst and returns the
+// extended buffer. It does not allocate when dst has enough capacity.
+func (m Money) AppendText(dst []byte) []byte {
+	var buf [20]byte
+	return appendText(dst, strconv.AppendUint(buf[:0], magnitude(m.amount), 10), m.amount < 0, m.cur())
+}
 
-	if len(minorUnitsStr) <= m.currency.Fraction {
-		minorUnitsStr = strings.Repeat("0", m.currency.Fraction-len(minorUnitsStr)) + minorUnitsStr
+// appendText appends the decimal digits of an absolute amount in minor units
+// in the text form read by splitText to dst.
+func appendText(dst, digits []byte, negative bool, c *Currency) []byte {
+	if negative {
+		dst = append(dst, '-')
 	}
 
-	return []byte(fmt.Sprintf(`%s%s%s %s`, majorUnitsStr, m.currency.Decimal, minorUnitsStr, m.currency.Code)), nil
+	switch n := len(digits) - c.Fraction; {
+	case c.Fraction == 0:
+		dst = append(dst, digits...)
+		dst = append(dst, c.Decimal...)
+		dst = append(dst, '0')
+	case n > 0:
+		dst = append(dst, digits[:n]...)
+		dst = append(dst, c.Decimal...)
+		dst = append(dst, digits[n:]...)
+	default:
+		dst = append(dst, '0')
+		dst = append(dst, c.Decimal...)
+		for ; n < 0; n++ {
+			dst = append(dst, '0')
+		}
+		dst = append(dst, digits...)
+	}
+
+	dst = append(dst, ' ')
+	return append(dst, c.Code...)
 }
 
 func (m Money) MarshalJSON() ([]byte, error) {
-	buff := bytes.NewBufferString(fmt.Sprintf(`{"amount": %d, "currency": "%s"}`, m.Amount(), m.Currency().Code))
-	return buff.Bytes(), nil
+	return m.AppendJSON(make([]byte, 0, 48)), nil
+}
+
+// AppendJSON appends the MarshalJSON form of m to dst and returns the
+// extended buffer. It does not allocate when dst has enough capacity.
+func (m Money) AppendJSON(dst []byte) []byte {
+	var buf [20]byte
+	return appendJSON(dst, strconv.AppendInt(buf[:0], m.amount, 10), m.cur())
+}
+
+// appendJSON appends the JSON object holding the signed decimal amount in
+// minor units and the currency code of c to dst.
+func appendJSON(dst, amount []byte, c *Currency) []byte {
+	dst = append(dst, `{"amount": `...)
+	dst = append(dst, amount...)
+	dst = append(dst, `, "currency": "`...)
+	dst = append(dst, c.Code...)
+	return append(dst, `"}`...)
 }
 
 func (m Money) SameCurrency(om Money) bool {
-	return m.currency == om.currency
+	return m.currency == om.currency || m.cur().Code == om.cur().Code
 }
 
 func (m Money) assertSameCurrency(om Money) error {
@@ -201,10 +276,29 @@ func (m Money) IsNegative() bool {
 
 // Operations
 
+// Absolute returns the absolute value of m. The absolute value of the
+// smallest possible amount saturates at math.MaxInt64; use CheckedAbsolute
+// to detect that case.
 func (m Money) Absolute() Money {
-	return Money{amount: absolute(m.amount), currency: m.currency}
+	a, ok := checkedAbs(m.amount)
+	if !ok {
+		a = saturate(false)
+	}
+
+	return Money{amount: a, currency: m.currency}
 }
 
+// CheckedAbsolute returns the absolute value of m or ErrOverflow if it does not fit into int64.
+func (m Money) CheckedAbsolute() (Money, error) {
+	a, ok := checkedAbs(m.amount)
+	if !ok {
+		return m, ErrOverflow
+	}
+
+	return Money{amount: a, currency: m.currency}, nil
+}
+
+// Negative returns the negative absolute value of m. It cannot overflow.
 func (m Money) Negative() Money {
 	if m.amount < 0 {
 		return m
@@ -212,28 +306,95 @@ func (m Money) Negative() Money {
 	return Money{amount: -1 * m.amount, currency: m.currency}
 }
 
+// Add returns the sum of m and om. It returns ErrOverflow if the sum does not fit into int64.
 func (m Money) Add(om Money) (Money, error) {
 	if err := m.assertSameCurrency(om); err != nil {
 		return om, err
 	}
 
-	return Money{amount: m.amount + om.amount, currency: m.currency}, nil
+	a, ok := checkedAdd(m.amount, om.amount)
+	if !ok {
+		return m, ErrOverflow
+	}
+
+	return Money{amount: a, currency: m.currency}, nil
 }
 
+// Subtract returns the difference of m and om. It returns ErrOverflow if the difference does not fit into int64.
 func (m Money) Subtract(om Money) (Money, error) {
 	if err := m.assertSameCurrency(om); err != nil {
 		return om, err
 	}
 
-	return Money{amount: m.amount - om.amount, currency: m.currency}, nil
+	a, ok := checkedSub(m.amount, om.amount)
+	if !ok {
+		return m, ErrOverflow
+	}
+
+	return Money{amount: a, currency: m.currency}, nil
 }
 
+// Multiply returns m multiplied by mul. The result wraps around on overflow;
+// use CheckedMultiply or SaturatingMultiply when that is not acceptable.
 func (m Money) Multiply(mul int64) Money {
 	return Money{amount: m.amount * mul, currency: m.currency}
 }
 
+// CheckedMultiply returns m multiplied by mul or ErrOverflow if the product does not fit into int64.
+func (m Money) CheckedMultiply(mul int64) (Money, error) {
+	a, ok := checkedMul(m.amount, mul)
+	if !ok {
+		return m, ErrOverflow
+	}
+
+	return Money{amount: a, currency: m.currency}, nil
+}
+
+// SaturatingAdd returns the sum of m and om clamped to the int64 range.
+func (m Money) SaturatingAdd(om Money) (Money, error) {
+	if err := m.assertSameCurrency(om); err != nil {
+		return om, err
+	}
+
+	a, ok := checkedAdd(m.amount, om.amount)
+	if !ok {
+		a = saturate(om.amount < 0)
+	}
+
+	return Money{amount: a, currency: m.currency}, nil
+}
+
+// SaturatingSubtract returns the difference of m and om clamped to the int64 range.
+func (m Money) SaturatingSubtract(om Money) (Money, error) {
+	if err := m.assertSameCurrency(om); err != nil {
+		return om, err
+	}
+
+	a, ok := checkedSub(m.amount, om.amount)
+	if !ok {
+		a = saturate(om.amount > 0)
+	}
+
+	return Money{amount: a, currency: m.currency}, nil
+}
+
+// SaturatingMultiply returns m multiplied by mul clamped to the int64 range.
+func (m Money) SaturatingMultiply(mul int64) Money {
+	a, ok := checkedMul(m.amount, mul)
+	if !ok {
+		a = saturate((m.amount < 0) != (mul < 0))
+	}
+
+	return Money{amount: a, currency: m.currency}
+}
+
+// Round rounds m to whole major units with exact halves rounded towards zero.
+// Amounts that would round beyond the int64 range saturate at the closest
+// whole amount that fits; use RoundTo to detect that case. Use RoundTo for
+// other precisions and rounding modes too.
 func (m Money) Round() Money {
-	return Money{amount: round(m.amount, m.currency.Fraction), currency: m.currency}
+	a, _ := round(m.amount, m.cur().Fraction)
+	return Money{amount: a, currency: m.currency}
 }
 
 // Helpers
@@ -272,41 +433,7 @@ func (m Money) Split(n int) ([]Money, error) {
 // It lets split money by given Ratios without losing pennies and as Split operations distributes
 // leftover pennies amongst the parties with round-robin principle.
 func (m Money) Allocate(rs ...int) ([]Money, error) {
-	if len(rs) == 0 {
-		return nil, ErrNoRatios
-	}
-
-	// Calculate sum of Ratios.
-	var sum int
-	for _, r := range rs {
-		sum += r
-	}
-
-	var total int64
-	ms := make([]Money, 0, len(rs))
-	for _, r := range rs {
-		party := Money{
-			amount:   allocate(m.amount, r, sum),
-			currency: m.currency,
-		}
-
-		ms = append(ms, party)
-		total += party.amount
-	}
-
-	// Calculate leftover value and divide to first parties.
-	lo := m.amount - total
-	sub := int64(1)
-	if lo < 0 {
-		sub = -sub
-	}
-
-	for p := 0; lo != 0; p++ {
-		ms[p].amount = ms[p].amount + sub
-		lo -= sub
-	}
-
-	return ms, nil
+	return m.AllocateWith(RemainderToFirst, rs...)
 }
 
 // It panics if error occurs.
@@ -3,6 +3,8 @@ package monies_test
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"unsafe"
 
 	"github.com/Craftserve/monies"
 
@@ -73,6 +75,25 @@ func TestSameCurrency(t *testing.T) {
 	sameCurrency, err := monies.New(0, monies.EUR)
 	assert.NoError(t, err)
 	assert.Equal(t, true, m.SameCurrency(sameCurrency))
+
+	// Currency identity is decided by code, whatever the display conventions.
+	euro, err := monies.CurrencyByCode(monies.EUR)
+	require.NoError(t, err)
+	euro.Decimal = ","
+	tenant, err := monies.NewRegistry(euro)
+	require.NoError(t, err)
+	tenantEuro, err := tenant.New(0, monies.EUR)
+	require.NoError(t, err)
+	assert.True(t, m.SameCurrency(tenantEuro))
+}
+
+func TestMoneyCompact(t *testing.T) {
+	assert.Equal(t, uintptr(16), unsafe.Sizeof(monies.Money{}))
+
+	var zero monies.Money
+	assert.Equal(t, monies.Currency{}, zero.Currency())
+	assert.NotPanics(t, func() { _ = zero.String() })
+	assert.False(t, zero.SameCurrency(monies.MustNew(0, monies.EUR)))
 }
 
 func TestEquals(t *testing.T) {
@@ -292,6 +313,69 @@ func TestMultiply(t *testing.T) {
 	}
 }
 
+func TestOverflow(t *testing.T) {
+	max := monies.MustNew(math.MaxInt64, monies.EUR)
+	min := monies.MustNew(math.MinInt64, monies.EUR)
+	one := monies.MustNew(1, monies.EUR)
+
+	_, err := max.Add(one)
+	assert.ErrorIs(t, err, monies.ErrOverflow)
+
+	_, err = min.Subtract(one)
+	assert.ErrorIs(t, err, monies.ErrOverflow)
+
+	_, err = max.CheckedMultiply(2)
+	assert.ErrorIs(t, err, monies.ErrOverflow)
+
+	_, err = min.CheckedMultiply(-1)
+	assert.ErrorIs(t, err, monies.ErrOverflow)
+
+	_, err = min.CheckedAbsolute()
+	assert.ErrorIs(t, err, monies.ErrOverflow)
+
+	result, err := max.CheckedMultiply(1)
+	assert.NoError(t, err)
+	assert.Equal(t, max, result)
+
+	result, err = min.Add(max)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-1), result.Amount())
+
+	assert.Equal(t, int64(math.MaxInt64), min.Absolute().Amount())
+	assert.Equal(t, int64(math.MinInt64), min.Negative().Amount())
+	assert.Equal(t, "-€92,233,720,368,547,758.08", min.String())
+}
+
+func TestSaturating(t *testing.T) {
+	max := monies.MustNew(math.MaxInt64, monies.EUR)
+	min := monies.MustNew(math.MinInt64, monies.EUR)
+	one := monies.MustNew(1, monies.EUR)
+
+	result, err := max.SaturatingAdd(one)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64), result.Amount())
+
+	result, err = min.SaturatingAdd(one.Negative())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(math.MinInt64), result.Amount())
+
+	result, err = min.SaturatingSubtract(one)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(math.MinInt64), result.Amount())
+
+	result, err = max.SaturatingSubtract(one.Negative())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(math.MaxInt64), result.Amount())
+
+	_, err = max.SaturatingAdd(monies.MustNew(1, monies.USD))
+	assert.ErrorIs(t, err, monies.ErrCurrencyMismatch)
+
+	assert.Equal(t, int64(math.MaxInt64), max.SaturatingMultiply(3).Amount())
+	assert.Equal(t, int64(math.MinInt64), max.SaturatingMultiply(-3).Amount())
+	assert.Equal(t, int64(math.MaxInt64), min.SaturatingMultiply(-1).Amount())
+	assert.Equal(t, int64(-200), monies.MustNew(100, monies.EUR).SaturatingMultiply(-2).Amount())
+}
+
 func TestRound(t *testing.T) {
 	testCases := []struct {
 		Name     string
@@ -362,6 +446,9 @@ func TestMoneyAllocate(t *testing.T) {
 		{monies.MustNew(5, monies.EUR), []int{50, 25, 25}, []int64{3, 1, 1}, nil},
 		{monies.MustNew(-101, monies.EUR), []int{50, 50}, []int64{-51, -50}, nil},
 		{monies.MustNew(-101, monies.EUR), []int{}, []int64{-26, -25}, monies.ErrNoRatios},
+		{monies.MustNew(math.MaxInt64, monies.EUR), []int{1, 1}, []int64{4611686018427387904, 4611686018427387903}, nil},
+		{monies.MustNew(math.MinInt64, monies.EUR), []int{3, 1}, []int64{-6917529027641081856, -2305843009213693952}, nil},
+		{monies.MustNew(100, monies.EUR), []int{math.MaxInt64, 1}, nil, monies.ErrOverflow},
 	}
 
 	for index, tC := range testCases {
@@ -392,6 +479,9 @@ func TestMoneyDisplay(t *testing.T) {
 		{monies.MustNew(-100, monies.GBP), "-£1.00"},
 		{monies.MustNew(10, monies.GBP), "£0.10"},
 		{monies.MustNew(100000, monies.GBP), "£1,000.00"},
+		{monies.MustNew(123456, monies.BRL), "R$1.234,56"},
+		{monies.MustNew(100, monies.TWD), "NT$1.00"},
+		{monies.MustNew(-100, monies.ZWL), "-Z$1.00"},
 	}
 
 	for _, tC := range testCases {
@@ -460,6 +550,48 @@ func TestMarshalJSON(t *testing.T) {
 	}
 }
 
+func TestAppend(t *testing.T) {
+	testCases := []struct {
+		Name string
+		m    monies.Money
+	}{
+		{"SUCCESS", monies.MustNew(123456, monies.PLN)},
+		{"NEGATIVE", monies.MustNew(-5, monies.USD)},
+		{"NO_FRACTION", monies.MustNew(1234567, monies.JPY)},
+		{"THREE_DIGIT_FRACTION", monies.MustNew(-12345678, monies.KWD)},
+		{"MIN_INT64", monies.MustNew(math.MinInt64, monies.EUR)},
+		{"ZERO_VALUE", monies.Money{}},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Name, func(t *testing.T) {
+			prefix := []byte("prefix:")
+
+			assert.Equal(t, "prefix:"+tC.m.String(), string(tC.m.AppendString(prefix)))
+
+			j, err := tC.m.MarshalJSON()
+			require.NoError(t, err)
+			assert.Equal(t, "prefix:"+string(j), string(tC.m.AppendJSON(prefix)))
+
+			text, err := tC.m.MarshalText()
+			require.NoError(t, err)
+			assert.Equal(t, "prefix:"+string(text), string(tC.m.AppendText(prefix)))
+		})
+	}
+}
+
+func TestAppendDoesNotAllocate(t *testing.T) {
+	m := monies.MustNew(-123456789, monies.PLN)
+	buf := make([]byte, 0, 64)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = m.AppendString(buf[:0])
+		buf = m.AppendJSON(buf[:0])
+		buf = m.AppendText(buf[:0])
+	})
+	assert.Zero(t, allocs)
+}
+
 func TestUnmarshalJSON(t *testing.T) {
 	type testCase struct {
 		Name          string
@@ -644,3 +776,125 @@ func TestTextUnmarshal(t *testing.T) {
 		})
 	}
 }
+
+// TestTextSigned checks that a sign covers the whole amount and overflow fails.
+func TestTextSigned(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Money       monies.Money
+		Text        string
+		ExpectedErr error
+	}{
+		{"POSITIVE", monies.MustNew(150, monies.USD), "1.50 USD", nil},
+		{"NEGATIVE", monies.MustNew(-150, monies.USD), "-1.50 USD", nil},
+		{"NEGATIVE_MINOR", monies.MustNew(-5, monies.USD), "-0.05 USD", nil},
+		{"NEGATIVE_NO_FRACTION", monies.MustNew(-10000, monies.VND), "-10000.0 VND", nil},
+		{"MAX", monies.MustNew(math.MaxInt64, monies.USD), "92233720368547758.07 USD", nil},
+		{"MIN", monies.MustNew(math.MinInt64, monies.USD), "-92233720368547758.08 USD", nil},
+		{"OVERFLOW", monies.Money{}, "92233720368547758.08 USD", monies.ErrOverflow},
+		{"MISSING_DECIMAL", monies.Money{}, "100 USD", monies.ErrInvalidText},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Name, func(t *testing.T) {
+			var m monies.Money
+			err := m.UnmarshalText([]byte(tC.Text))
+			if tC.ExpectedErr != nil {
+				assert.ErrorIs(t, err, tC.ExpectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tC.Money, m)
+
+			text, err := tC.Money.MarshalText()
+			require.NoError(t, err)
+			assert.Equal(t, tC.Text, string(text))
+		})
+	}
+
+	// Unsigned major units followed by a short fraction keep being read as
+	// before: the fraction is a number of minor units.
+	var m monies.Money
+	require.NoError(t, m.UnmarshalText([]byte("1.5 USD")))
+	assert.Equal(t, monies.MustNew(105, monies.USD), m)
+}
+
+func BenchmarkAdd(b *testing.B) {
+	m, om := monies.MustNew(100, monies.EUR), monies.MustNew(200, monies.EUR)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = m.Add(om)
+	}
+}
+
+func BenchmarkSameCurrency(b *testing.B) {
+	m, om := monies.MustNew(100, monies.EUR), monies.MustNew(200, monies.EUR)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = m.SameCurrency(om)
+	}
+}
+
+var moneySink []monies.Money
+
+func BenchmarkMoneySlice(b *testing.B) {
+	m := monies.MustNew(100, monies.EUR)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		moneySink = make([]monies.Money, 1000)
+		for j := range moneySink {
+			moneySink[j] = m
+		}
+	}
+}
+
+var bytesSink []byte
+
+func BenchmarkString(b *testing.B) {
+	m := monies.MustNew(-123456789, monies.PLN)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = m.String()
+	}
+}
+
+func BenchmarkAppendString(b *testing.B) {
+	m := monies.MustNew(-123456789, monies.PLN)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		bytesSink = m.AppendString(bytesSink[:0])
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	m := monies.MustNew(-123456789, monies.PLN)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		bytesSink, _ = m.MarshalJSON()
+	}
+}
+
+func BenchmarkAppendJSON(b *testing.B) {
+	m := monies.MustNew(-123456789, monies.PLN)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		bytesSink = m.AppendJSON(bytesSink[:0])
+	}
+}
+
+func BenchmarkMarshalText(b *testing.B) {
+	m := monies.MustNew(-123456789, monies.PLN)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		bytesSink, _ = m.MarshalText()
+	}
+}
+
+func BenchmarkAppendText(b *testing.B) {
+	m := monies.MustNew(-123456789, monies.PLN)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		bytesSink = m.AppendText(bytesSink[:0])
+	}
+}
@@ -0,0 +1,277 @@
+package monies
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode"
+	"unicode/utf8"
+)
+
+// numberPattern is a parsed CLDR number pattern, such as "¤#,##0.00" or
+// "#,##,##0.00 ¤;(#,##,##0.00 ¤)".
+//
+// In a pattern, "0" and "#" stand for digits, "." for the decimal separator
+// and "," for the group separator. The text before and after the number is
+// the prefix and the suffix, in which "¤" stands for the currency symbol and
+// "-" for the minus sign, and text in single quotes is taken literally. A
+// negative subpattern may follow a ";"; only its prefix and suffix are used.
+// Without one, negative amounts are prefixed with the minus sign. The number
+// of fraction digits is not taken from the pattern but from the currency.
+type numberPattern struct {
+	posPrefix, posSuffix string
+	negPrefix, negSuffix string
+	// hasNeg tells whether the pattern has a negative subpattern.
+	hasNeg bool
+	// primary is the size of the group next to the decimal separator and
+	// secondary the size of the other groups. Zero means no grouping.
+	primary, secondary int
+}
+
+// patternSymbols are the strings a numberPattern is rendered with.
+type patternSymbols struct {
+	decimal  string
+	group    string
+	minus    string
+	currency string
+	// spacing separates a currency symbol that ends or starts with a letter
+	// from the digits next to it, as in "CHF 12.00". Empty disables it.
+	spacing string
+}
+
+// parsePattern parses a CLDR number pattern.
+func parsePattern(s string) (numberPattern, error) {
+	pos, neg := s, ""
+	if i := strings.IndexByte(s, ';'); i >= 0 {
+		pos, neg = s[:i], s[i+1:]
+	}
+
+	prefix, number, suffix, err := splitPattern(pos)
+	if err != nil {
+		return numberPattern{}, err
+	}
+
+	p := numberPattern{posPrefix: prefix, posSuffix: suffix}
+	if neg != "" {
+		if p.negPrefix, _, p.negSuffix, err = splitPattern(neg); err != nil {
+			return numberPattern{}, err
+		}
+		p.hasNeg = true
+	}
+
+	integer := number
+	if i := strings.IndexByte(number, '.'); i >= 0 {
+		integer = number[:i]
+	}
+	if i := strings.LastIndexByte(integer, ','); i >= 0 {
+		p.primary, p.secondary = len(integer)-i-1, len(integer)-i-1
+		if j := strings.LastIndexByte(integer[:i], ','); j >= 0 {
+			p.secondary = i - j - 1
+		}
+		if p.primary == 0 || p.secondary == 0 {
+			return numberPattern{}, fmt.Errorf("pattern %q has an empty group", s)
+		}
+	}
+
+	return p, nil
+}
+
+// maxCachedPatterns bounds the number of patterns kept by cachedPattern.
+const maxCachedPatterns = 1024
+
+var (
+	// patternCache holds a map[string]numberPattern of the patterns parsed
+	// so far. The map is replaced rather than modified, so that readers need
+	// no lock.
+	patternCache   atomic.Value
+	patternCacheMu sync.Mutex
+)
+
+// cachedPattern is parsePattern for patterns that are used over and over,
+// such as those of currencies.
+func cachedPattern(s string) (numberPattern, error) {
+	cache, _ := patternCache.Load().(map[string]numberPattern)
+	if p, ok := cache[s]; ok {
+		return p, nil
+	}
+
+	p, err := parsePattern(s)
+	if err != nil || len(cache) >= maxCachedPatterns {
+		return p, err
+	}
+
+	patternCacheMu.Lock()
+	defer patternCacheMu.Unlock()
+
+	cache, _ = patternCache.Load().(map[string]numberPattern)
+	next := make(map[string]numberPattern, len(cache)+1)
+	for k, v := range cache {
+		next[k] = v
+	}
+	next[s] = p
+	patternCache.Store(next)
+
+	return p, nil
+}
+
+// splitPattern splits a subpattern into the prefix, the number and the
+// suffix.
+func splitPattern(s string) (prefix, number, suffix string, err error) {
+	start, end, quoted := -1, -1, false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\'':
+			quoted = !quoted
+		case !quoted && (c == '#' || c >= '0' && c <= '9'):
+			if start < 0 {
+				start = i
+			}
+			end = i + 1
+		}
+	}
+	if start < 0 {
+		return "", "", "", fmt.Errorf("pattern %q has no digits", s)
+	}
+
+	for i := start; i < end; i++ {
+		if c := s[i]; c != '#' && c != ',' && c != '.' && (c < '0' || c > '9') {
+			return "", "", "", fmt.Errorf("pattern %q has an invalid number", s)
+		}
+	}
+
+	return s[:start], s[start:end], s[end:], nil
+}
+
+// append renders the decimal digits of an absolute amount with the given
+// number of fraction digits to dst.
+func (p *numberPattern) append(dst, digits []byte, negative bool, fraction int, s patternSymbols) []byte {
+	prefix, suffix := p.posPrefix, p.posSuffix
+	if negative {
+		if p.hasNeg {
+			prefix, suffix = p.negPrefix, p.negSuffix
+		} else {
+			dst = append(dst, s.minus...)
+		}
+	}
+
+	dst = appendPrefix(dst, prefix, s)
+	dst = appendDigits(dst, digits, fraction, s.decimal, s.group, p.primary, p.secondary)
+	return appendSuffix(dst, suffix, s)
+}
+
+// appendPrefix appends the prefix of a pattern to dst, followed by the
+// currency spacing if the prefix ends with a symbol that needs it.
+func appendPrefix(dst []byte, prefix string, s patternSymbols) []byte {
+	dst = appendAffix(dst, prefix, s)
+	if r, _ := utf8.DecodeLastRuneInString(s.currency); strings.HasSuffix(prefix, "¤") && needsSpacing(r) {
+		dst = append(dst, s.spacing...)
+	}
+
+	return dst
+}
+
+// appendSuffix appends the suffix of a pattern to dst, preceded by the
+// currency spacing if the suffix starts with a symbol that needs it.
+func appendSuffix(dst []byte, suffix string, s patternSymbols) []byte {
+	if r, _ := utf8.DecodeRuneInString(s.currency); strings.HasPrefix(suffix, "¤") && needsSpacing(r) {
+		dst = append(dst, s.spacing...)
+	}
+
+	return appendAffix(dst, suffix, s)
+}
+
+// appendAffix appends a prefix or suffix of a pattern to dst, substituting
+// the currency symbol and the minus sign. Text in single quotes is copied
+// as is and two single quotes stand for one.
+func appendAffix(dst []byte, affix string, s patternSymbols) []byte {
+	quoted := false
+	for i := 0; i < len(affix); {
+		r, size := utf8.DecodeRuneInString(affix[i:])
+		switch {
+		case r == '\'' && strings.HasPrefix(affix[i+size:], "'"):
+			dst = append(dst, '\'')
+			size++
+		case r == '\'':
+			quoted = !quoted
+		case quoted:
+			dst = append(dst, affix[i:i+size]...)
+		case r == '¤':
+			dst = append(dst, s.currency...)
+		case r == '-':
+			dst = append(dst, s.minus...)
+		default:
+			dst = append(dst, affix[i:i+size]...)
+		}
+		i += size
+	}
+
+	return dst
+}
+
+// needsSpacing reports whether a symbol with r next to the digits must be
+// separated from them: CLDR keeps currency signs such as "$" close, but not
+// letters.
+func needsSpacing(r rune) bool {
+	return r != utf8.RuneError && !unicode.IsSymbol(r) && !unicode.Is(unicode.Z, r)
+}
+
+// appendDigits appends the decimal digits of an absolute amount with the
+// given number of fraction digits to dst, padded with zeros to at least one
+// integer digit. The integer digits are grouped by primary next to the
+// decimal separator and by secondary further left; zero disables grouping.
+func appendDigits(dst, digits []byte, fraction int, decimal, group string, primary, secondary int) []byte {
+	pad := fraction + 1 - len(digits)
+	if pad < 0 {
+		pad = 0
+	}
+
+	integer := pad + len(digits) - fraction
+	for i := 0; i < integer+fraction; i++ {
+		switch k := integer - i; {
+		case i == integer:
+			dst = append(dst, decimal...)
+		case i == 0 || k < 0 || primary == 0:
+		case k == primary, k > primary && secondary > 0 && (k-primary)%secondary == 0:
+			dst = append(dst, group...)
+		}
+
+		if i < pad {
+			dst = append(dst, '0')
+		} else {
+			dst = append(dst, digits[i-pad])
+		}
+	}
+
+	return dst
+}
+
+// templatePattern converts a template of the form used before patterns, in
+// which the first "1" stands for the amount and the first "$" for the
+// grapheme, such as "$1" or "1 $", to a pattern for the given number of
+// fraction digits.
+func templatePattern(template string, fraction int) string {
+	number := "#,##0"
+	if fraction > 0 {
+		number += "." + strings.Repeat("0", fraction)
+	}
+
+	amount, symbol := strings.IndexByte(template, '1'), strings.IndexByte(template, '$')
+	var b strings.Builder
+	for i := 0; i < len(template); i++ {
+		switch c := template[i]; {
+		case i == amount:
+			b.WriteString(number)
+		case i == symbol:
+			b.WriteString("¤")
+		case c == '\'':
+			b.WriteString("''")
+		case strings.IndexByte("#0123456789;-", c) >= 0:
+			b.WriteString("'" + string(c) + "'")
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,56 @@
+package monies_test
+
+import (
+	"testing"
+
+	"github.com/Craftserve/monies"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrencyPattern(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		pattern  string
+		amount   int64
+		expected string
+	}{
+		{"PREFIX", "¤#,##0.00", 123456, "$1,234.56"},
+		{"SUFFIX", "#,##0.00 ¤", -123456, "-1,234.56 $"},
+		{"NEGATIVE_SUBPATTERN", "¤#,##0.00;(¤#,##0.00)", -123456, "($1,234.56)"},
+		{"NEGATIVE_SUBPATTERN_POSITIVE", "¤#,##0.00;(¤#,##0.00)", 123456, "$1,234.56"},
+		{"NEGATIVE_SUBPATTERN_MINUS", "¤ #,##0.00;¤ -#,##0.00", -123456, "$ -1,234.56"},
+		{"SECONDARY_GROUPING", "#,##,##0.00 ¤", 1234567890, "1,23,45,678.90 $"},
+		{"NO_GROUPING", "¤0.00", 1234567890, "$12345678.90"},
+		{"QUOTED", "'#'¤ #,##0.00 'pay'", 100, "#$ 1.00 pay"},
+		{"QUOTE", "¤ #,##0.00 o''clock", 100, "$ 1.00 o'clock"},
+		{"DOLLAR_SIGN", "#,##0.00 $", 100, "1.00 $"},
+		{"FRACTION_FROM_CURRENCY", "¤#,##0", 5, "$0.05"},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Name, func(t *testing.T) {
+			c := monies.Currency{Code: "XTS", Fraction: 2, Grapheme: "$", Pattern: tC.pattern, Decimal: ".", Thousand: ","}
+			r, err := monies.NewRegistry(c)
+			require.NoError(t, err)
+
+			m, err := r.New(tC.amount, "XTS")
+			require.NoError(t, err)
+			assert.Equal(t, tC.expected, m.String())
+		})
+	}
+}
+
+func TestCurrencyPatternDollarGraphemes(t *testing.T) {
+	c := monies.Currency{Code: "XTS", Fraction: 2, Grapheme: "R$", Pattern: "#,##0.00 ¤;-#,##0.00 ¤", Decimal: ",", Thousand: "."}
+	r, err := monies.NewRegistry(c)
+	require.NoError(t, err)
+
+	m, err := r.New(-123456, "XTS")
+	require.NoError(t, err)
+	assert.Equal(t, "-1.234,56 R$", m.String())
+
+	text, err := m.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "-1234,56 XTS", string(text))
+}
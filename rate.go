@@ -0,0 +1,77 @@
+package monies
+
+import (
+	"errors"
+	"math/big"
+)
+
+var ErrInvalidDecimal = errors.New("invalid decimal")
+
+// MultiplyRate returns m multiplied by rate and rounded with mode. It also
+// returns the residue: the exact product minus the rounded amount, expressed
+// in minor units.
+func (m Money) MultiplyRate(rate *big.Rat, mode RoundingMode) (Money, *big.Rat, error) {
+	if rate == nil {
+		return m, nil, ErrInvalidDecimal
+	}
+
+	exact := new(big.Rat).SetInt64(m.amount)
+	return m.fromRat(exact.Mul(exact, rate), mode)
+}
+
+// MultiplyDecimal works like MultiplyRate, but takes the rate as a decimal
+// string such as "0.23" or "-2.75".
+func (m Money) MultiplyDecimal(decimal string, mode RoundingMode) (Money, *big.Rat, error) {
+	rate, err := parseDecimal(decimal)
+	if err != nil {
+		return m, nil, err
+	}
+
+	return m.MultiplyRate(rate, mode)
+}
+
+// fromRat rounds the exact amount r, given in minor units, to Money in m's
+// currency and returns the rounding residue next to it.
+func (m Money) fromRat(r *big.Rat, mode RoundingMode) (Money, *big.Rat, error) {
+	if !mode.valid() {
+		return m, nil, ErrInvalidRoundingMode
+	}
+
+	q := quoRound(r.Num(), r.Denom(), mode)
+	if !q.IsInt64() {
+		return m, nil, ErrOverflow
+	}
+
+	residue := new(big.Rat).SetInt(q)
+	residue.Sub(r, residue)
+
+	return Money{amount: q.Int64(), currency: m.currency}, residue, nil
+}
+
+// parseDecimal parses a plain decimal number: an optional sign followed by
+// digits with an optional decimal point. Fractions and exponents are rejected.
+func parseDecimal(s string) (*big.Rat, error) {
+	digits, point := 0, false
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c >= '0' && c <= '9':
+			digits++
+		case c == '.' && !point:
+			point = true
+		case (c == '+' || c == '-') && i == 0:
+		default:
+			return nil, ErrInvalidDecimal
+		}
+	}
+
+	if digits == 0 {
+		return nil, ErrInvalidDecimal
+	}
+
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, ErrInvalidDecimal
+	}
+
+	return r, nil
+}
@@ -0,0 +1,65 @@
+package monies_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/Craftserve/monies"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiplyDecimal(t *testing.T) {
+	testCases := []struct {
+		Money           monies.Money
+		Rate            string
+		Mode            monies.RoundingMode
+		Expected        int64
+		ExpectedResidue string
+		ExpectedErr     error
+	}{
+		{monies.MustNew(10000, monies.EUR), "0.23", monies.RoundHalfUp, 2300, "0", nil},
+		{monies.MustNew(999, monies.EUR), "0.125", monies.RoundHalfUp, 125, "-1/8", nil},
+		{monies.MustNew(999, monies.EUR), "0.125", monies.RoundDown, 124, "7/8", nil},
+		{monies.MustNew(1999, monies.EUR), "2.75", monies.RoundHalfEven, 5497, "1/4", nil},
+		{monies.MustNew(-1999, monies.EUR), "2.75", monies.RoundFloor, -5498, "3/4", nil},
+		{monies.MustNew(250, monies.EUR), "0.1", monies.RoundHalfEven, 25, "0", nil},
+		{monies.MustNew(25, monies.EUR), "0.1", monies.RoundHalfEven, 2, "1/2", nil},
+		{monies.MustNew(35, monies.EUR), "0.1", monies.RoundHalfEven, 4, "-1/2", nil},
+		{monies.MustNew(100, monies.EUR), "-.5", monies.RoundHalfUp, -50, "0", nil},
+		{monies.MustNew(100, monies.EUR), "+1.", monies.RoundHalfUp, 100, "0", nil},
+		{monies.MustNew(math.MaxInt64, monies.EUR), "1.5", monies.RoundHalfUp, 0, "", monies.ErrOverflow},
+		{monies.MustNew(100, monies.EUR), "1/3", monies.RoundHalfUp, 0, "", monies.ErrInvalidDecimal},
+		{monies.MustNew(100, monies.EUR), "1e3", monies.RoundHalfUp, 0, "", monies.ErrInvalidDecimal},
+		{monies.MustNew(100, monies.EUR), "1.2.3", monies.RoundHalfUp, 0, "", monies.ErrInvalidDecimal},
+		{monies.MustNew(100, monies.EUR), "-", monies.RoundHalfUp, 0, "", monies.ErrInvalidDecimal},
+		{monies.MustNew(100, monies.EUR), "", monies.RoundHalfUp, 0, "", monies.ErrInvalidDecimal},
+		{monies.MustNew(100, monies.EUR), "0.5", monies.RoundingMode(99), 0, "", monies.ErrInvalidRoundingMode},
+	}
+
+	for index, tC := range testCases {
+		t.Run(fmt.Sprintf("#%d", index), func(t *testing.T) {
+			result, residue, err := tC.Money.MultiplyDecimal(tC.Rate, tC.Mode)
+			assert.ErrorIs(t, err, tC.ExpectedErr)
+
+			if tC.ExpectedErr == nil {
+				assert.Equal(t, tC.Expected, result.Amount())
+				assert.Equal(t, tC.ExpectedResidue, residue.RatString())
+				assert.True(t, result.SameCurrency(tC.Money))
+			}
+		})
+	}
+}
+
+func TestMultiplyRate(t *testing.T) {
+	m := monies.MustNew(100, monies.EUR)
+
+	result, residue, err := m.MultiplyRate(big.NewRat(1, 3), monies.RoundHalfUp)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(33), result.Amount())
+	assert.Equal(t, "1/3", residue.RatString())
+
+	_, _, err = m.MultiplyRate(nil, monies.RoundHalfUp)
+	assert.ErrorIs(t, err, monies.ErrInvalidDecimal)
+}
@@ -0,0 +1,214 @@
+package monies
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+)
+
+// euroConversionRates holds the irrevocable rates, in units of the national
+// currency per euro, fixed by the Council of the EU for currencies that were
+// replaced by the euro.
+var euroConversionRates = map[CurrencyCode]string{
+	BGN: "1.95583",
+	EEK: "15.6466",
+	HRK: "7.53450",
+	LTL: "3.45280",
+	LVL: "0.702804",
+	SKK: "30.1260",
+}
+
+// Redenomination converts amounts from one currency to another at a fixed,
+// legally set rate, as when a currency is replaced by a new one.
+type Redenomination struct {
+	from *Currency
+	to   *Currency
+	rate *big.Rat
+	mode RoundingMode
+}
+
+// NewRedenomination returns the redenomination from one currency to another
+// of the default registry. See Registry.NewRedenomination.
+func NewRedenomination(from, to CurrencyCode, rate string, mode RoundingMode) (*Redenomination, error) {
+	return defaultRegistry.NewRedenomination(from, to, rate, mode)
+}
+
+// NewRedenomination returns the redenomination from one currency of r to
+// another. The rate is a positive decimal string giving the number of units
+// of from that equal one unit of to, e.g. "1.95583" for BGN to EUR. Every
+// converted amount is rounded to the minor unit of to with mode.
+func (r *Registry) NewRedenomination(from, to CurrencyCode, rate string, mode RoundingMode) (*Redenomination, error) {
+	if !mode.valid() {
+		return nil, ErrInvalidRoundingMode
+	}
+
+	q, err := parseDecimal(rate)
+	if err != nil {
+		return nil, err
+	}
+	if q.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: rate must be positive", ErrInvalidDecimal)
+	}
+
+	fc, err := r.handle(from)
+	if err != nil {
+		return nil, err
+	}
+
+	tc, err := r.handle(to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Redenomination{from: fc, to: tc, rate: q, mode: mode}, nil
+}
+
+// EuroChangeover returns the redenomination of a national currency replaced
+// by the euro, such as BGN, at its irrevocable conversion rate. As required
+// by Council Regulation (EC) No 1103/97, the rate is applied as is, never
+// inverted, and results are rounded half up to the nearest cent.
+func EuroChangeover(code CurrencyCode) (*Redenomination, error) {
+	rate, ok := euroConversionRates[code]
+	if !ok {
+		return nil, fmt.Errorf("%w: no euro conversion rate for %s", ErrCurrencyNotFound, code)
+	}
+
+	return NewRedenomination(code, EUR, rate, RoundHalfUp)
+}
+
+// From returns the currency amounts are converted from.
+func (rd *Redenomination) From() Currency {
+	return *rd.from
+}
+
+// To returns the currency amounts are converted to.
+func (rd *Redenomination) To() Currency {
+	return *rd.to
+}
+
+// Rate returns the number of units of From that equal one unit of To.
+func (rd *Redenomination) Rate() *big.Rat {
+	return new(big.Rat).Set(rd.rate)
+}
+
+// Inverse returns the redenomination in the opposite direction, which
+// multiplies by the rate instead of dividing by it, e.g. from EUR to BGN.
+func (rd *Redenomination) Inverse() *Redenomination {
+	return &Redenomination{from: rd.to, to: rd.from, rate: new(big.Rat).Inv(rd.rate), mode: rd.mode}
+}
+
+// Redenominate converts m, which must be in the From currency, to the To
+// currency.
+func (rd *Redenomination) Redenominate(m Money) (Money, error) {
+	result, _, err := rd.convert(m, rd.mode)
+	return result, err
+}
+
+// convert returns m converted to the To currency and rounded with mode,
+// together with the rounding residue: the exact result minus the rounded
+// one, in minor units of To.
+func (rd *Redenomination) convert(m Money, mode RoundingMode) (Money, *big.Rat, error) {
+	if m.cur().Code != rd.from.Code {
+		return m, nil, ErrCurrencyMismatch
+	}
+
+	// Minor units of To per minor unit of From: 10^to.Fraction / (10^from.Fraction * rate).
+	toExp, _ := pow10(rd.to.Fraction)
+	fromExp, _ := pow10(rd.from.Fraction)
+	scale := new(big.Rat).SetFrac64(toExp, fromExp)
+	scale.Quo(scale, rd.rate)
+
+	exact := new(big.Rat).SetInt64(m.amount)
+	return Money{currency: rd.to}.fromRat(exact.Mul(exact, scale), mode)
+}
+
+// RedenominationItem records the conversion of a single amount.
+type RedenominationItem struct {
+	// Key names the ledger entry the amount belongs to. It is empty for
+	// items of RedenominateAll, which keep the order of their input.
+	Key      string
+	Original Money
+	Result   Money
+	// Difference is the exact converted amount minus Result, in minor units
+	// of the To currency.
+	Difference *big.Rat
+}
+
+// RedenominationReport lists the conversion of every amount of a bulk
+// redenomination along with the totals.
+type RedenominationReport struct {
+	Items []RedenominationItem
+	// Total is the sum of the converted amounts.
+	Total Money
+	// Difference is the sum of the differences of all items, that is the
+	// exact converted total minus Total, in minor units of the To currency.
+	Difference *big.Rat
+}
+
+// RedenominateAll converts every amount of ms and reports the rounding
+// difference of each of them. All amounts must be in the From currency.
+func (rd *Redenomination) RedenominateAll(ms []Money) ([]Money, *RedenominationReport, error) {
+	report := &RedenominationReport{
+		Items:      make([]RedenominationItem, 0, len(ms)),
+		Total:      Money{currency: rd.to},
+		Difference: new(big.Rat),
+	}
+
+	results := make([]Money, len(ms))
+	for i, m := range ms {
+		result, err := rd.addItem(report, "", m)
+		if err != nil {
+			return nil, nil, fmt.Errorf("item %d: %w", i, err)
+		}
+		results[i] = result
+	}
+
+	return results, report, nil
+}
+
+// RedenominateLedger converts the balance of every account of ledger and
+// reports the rounding difference of each of them. The report lists the
+// accounts sorted by key. All balances must be in the From currency.
+func (rd *Redenomination) RedenominateLedger(ledger map[string]Money) (map[string]Money, *RedenominationReport, error) {
+	keys := make([]string, 0, len(ledger))
+	for k := range ledger {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	report := &RedenominationReport{
+		Items:      make([]RedenominationItem, 0, len(ledger)),
+		Total:      Money{currency: rd.to},
+		Difference: new(big.Rat),
+	}
+
+	results := make(map[string]Money, len(ledger))
+	for _, k := range keys {
+		result, err := rd.addItem(report, k, ledger[k])
+		if err != nil {
+			return nil, nil, fmt.Errorf("account %q: %w", k, err)
+		}
+		results[k] = result
+	}
+
+	return results, report, nil
+}
+
+// addItem converts m and records it in report.
+func (rd *Redenomination) addItem(report *RedenominationReport, key string, m Money) (Money, error) {
+	result, residue, err := rd.convert(m, rd.mode)
+	if err != nil {
+		return m, err
+	}
+
+	total, err := report.Total.Add(result)
+	if err != nil {
+		return m, err
+	}
+
+	report.Items = append(report.Items, RedenominationItem{Key: key, Original: m, Result: result, Difference: residue})
+	report.Total = total
+	report.Difference.Add(report.Difference, residue)
+
+	return result, nil
+}
@@ -0,0 +1,128 @@
+package monies_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Craftserve/monies"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedenominate(t *testing.T) {
+	rd, err := monies.EuroChangeover(monies.BGN)
+	require.NoError(t, err)
+	assert.Equal(t, monies.BGN, rd.From().Code)
+	assert.Equal(t, monies.EUR, rd.To().Code)
+	assert.Equal(t, big.NewRat(195583, 100000), rd.Rate())
+
+	testCases := []struct {
+		Name     string
+		Amount   int64
+		Expected int64
+	}{
+		{"ZERO", 0, 0},
+		{"ONE_EURO", 196, 100},
+		{"PRICE", 1956, 1000},
+		{"HALF_UP", 1, 1},
+		{"LARGE", 10000000, 5112919},
+		{"NEGATIVE", -1956, -1000},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Name, func(t *testing.T) {
+			m, err := rd.Redenominate(monies.MustNew(tC.Amount, monies.BGN))
+			require.NoError(t, err)
+			assert.Equal(t, monies.MustNew(tC.Expected, monies.EUR), m)
+		})
+	}
+
+	_, err = rd.Redenominate(monies.MustNew(100, monies.EUR))
+	assert.ErrorIs(t, err, monies.ErrCurrencyMismatch)
+}
+
+func TestNewRedenomination(t *testing.T) {
+	rd, err := monies.NewRedenomination(monies.JPY, monies.USD, "150", monies.RoundDown)
+	require.NoError(t, err)
+
+	m, err := rd.Redenominate(monies.MustNew(1000, monies.JPY))
+	require.NoError(t, err)
+	assert.Equal(t, monies.MustNew(666, monies.USD), m)
+
+	_, err = monies.NewRedenomination(monies.JPY, monies.USD, "0", monies.RoundDown)
+	assert.ErrorIs(t, err, monies.ErrInvalidDecimal)
+	_, err = monies.NewRedenomination(monies.JPY, monies.USD, "1/150", monies.RoundDown)
+	assert.ErrorIs(t, err, monies.ErrInvalidDecimal)
+	_, err = monies.NewRedenomination(monies.JPY, monies.USD, "150", monies.RoundingMode(-1))
+	assert.ErrorIs(t, err, monies.ErrInvalidRoundingMode)
+	_, err = monies.NewRedenomination("XXX", monies.USD, "150", monies.RoundDown)
+	assert.ErrorIs(t, err, monies.ErrCurrencyNotFound)
+	_, err = monies.EuroChangeover(monies.PLN)
+	assert.ErrorIs(t, err, monies.ErrCurrencyNotFound)
+}
+
+func TestRedenominateAll(t *testing.T) {
+	rd, err := monies.EuroChangeover(monies.BGN)
+	require.NoError(t, err)
+
+	ms := []monies.Money{monies.MustNew(1956, monies.BGN), monies.MustNew(1, monies.BGN), monies.MustNew(1, monies.BGN)}
+	results, report, err := rd.RedenominateAll(ms)
+	require.NoError(t, err)
+	assert.Equal(t, []monies.Money{monies.MustNew(1000, monies.EUR), monies.MustNew(1, monies.EUR), monies.MustNew(1, monies.EUR)}, results)
+
+	require.Len(t, report.Items, 3)
+	assert.Equal(t, ms[0], report.Items[0].Original)
+	assert.Equal(t, results[0], report.Items[0].Result)
+	assert.Equal(t, big.NewRat(17000, 195583), report.Items[0].Difference)
+	assert.Equal(t, big.NewRat(100000-195583, 195583), report.Items[1].Difference)
+	assert.Equal(t, monies.MustNew(1002, monies.EUR), report.Total)
+
+	// The exact total of 1958 stotinki is 1001.11 cents.
+	exact := new(big.Rat).SetInt64(report.Total.Amount())
+	exact.Add(exact, report.Difference)
+	assert.Equal(t, big.NewRat(195800000, 195583), exact)
+
+	_, _, err = rd.RedenominateAll([]monies.Money{monies.MustNew(1, monies.BGN), monies.MustNew(1, monies.EUR)})
+	assert.ErrorIs(t, err, monies.ErrCurrencyMismatch)
+	assert.Contains(t, err.Error(), "item 1")
+}
+
+func TestRedenominateLedger(t *testing.T) {
+	rd, err := monies.EuroChangeover(monies.BGN)
+	require.NoError(t, err)
+
+	ledger := map[string]monies.Money{
+		"savings": monies.MustNew(10000000, monies.BGN),
+		"current": monies.MustNew(-1956, monies.BGN),
+	}
+	results, report, err := rd.RedenominateLedger(ledger)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]monies.Money{
+		"savings": monies.MustNew(5112919, monies.EUR),
+		"current": monies.MustNew(-1000, monies.EUR),
+	}, results)
+
+	require.Len(t, report.Items, 2)
+	assert.Equal(t, "current", report.Items[0].Key)
+	assert.Equal(t, "savings", report.Items[1].Key)
+	assert.Equal(t, monies.MustNew(5111919, monies.EUR), report.Total)
+
+	ledger["euro"] = monies.MustNew(1, monies.EUR)
+	_, _, err = rd.RedenominateLedger(ledger)
+	assert.ErrorIs(t, err, monies.ErrCurrencyMismatch)
+	assert.Contains(t, err.Error(), `account "euro"`)
+}
+
+func TestRedenominationInverse(t *testing.T) {
+	rd, err := monies.EuroChangeover(monies.BGN)
+	require.NoError(t, err)
+
+	inv := rd.Inverse()
+	assert.Equal(t, monies.EUR, inv.From().Code)
+	assert.Equal(t, monies.BGN, inv.To().Code)
+	assert.Equal(t, big.NewRat(100000, 195583), inv.Rate())
+
+	m, err := inv.Redenominate(monies.MustNew(1000, monies.EUR))
+	require.NoError(t, err)
+	assert.Equal(t, monies.MustNew(1956, monies.BGN), m)
+}
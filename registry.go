@@ -0,0 +1,408 @@
+package monies
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Registry is a set of currencies that amounts are created and parsed
+// against. Separate registries can hold different currencies, or the same
+// codes with different display conventions. A Registry is safe for
+// concurrent use.
+type Registry struct {
+	mu sync.RWMutex
+	// currencies holds the handles that Money values point to. A handle is
+	// never modified; Update replaces it with a new one instead.
+	currencies map[CurrencyCode]*Currency
+	// numeric indexes the codes by numeric code and sorted holds the
+	// currencies sorted by code. Both follow every change of currencies.
+	numeric map[string]CurrencyCode
+	sorted  []*Currency
+	strict  bool
+}
+
+// defaultRegistry holds the built-in currencies and backs the package-level
+// functions.
+var defaultRegistry = newRegistry(builtinCurrencies())
+
+// newRegistry returns a registry holding the currencies of m, which must
+// already be valid and have unique numeric codes.
+func newRegistry(m CurrenciesMap) *Registry {
+	r := &Registry{currencies: make(map[CurrencyCode]*Currency, len(m))}
+	for code, c := range m {
+		c := c
+		r.currencies[code] = &c
+	}
+	r.reindex()
+
+	return r
+}
+
+// reindex rebuilds the indexes of r. The caller must hold r.mu for writing
+// or have exclusive access to r.
+func (r *Registry) reindex() {
+	r.numeric = make(map[string]CurrencyCode, len(r.currencies))
+	r.sorted = make([]*Currency, 0, len(r.currencies))
+	for code, c := range r.currencies {
+		if c.NumericCode != "" {
+			r.numeric[c.NumericCode] = code
+		}
+		r.sorted = append(r.sorted, c)
+	}
+
+	sort.Slice(r.sorted, func(i, j int) bool {
+		return r.sorted[i].Code < r.sorted[j].Code
+	})
+}
+
+// DefaultRegistry returns the registry used by New, CurrencyByCode and the
+// unmarshalling methods of Money.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// NewRegistry returns a registry holding cs. Use DefaultRegistry().List() to
+// start from the built-in currencies.
+func NewRegistry(cs ...Currency) (*Registry, error) {
+	r := newRegistry(make(CurrenciesMap, len(cs)))
+	for _, c := range cs {
+		if err := r.Register(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// SetStrict turns the strict mode of r on or off. In strict mode New and
+// NewBig refuse currencies with the CurrencyWithdrawn status, while parsing
+// and unmarshalling still accept them so that historic data can be read.
+func (r *Registry) SetStrict(strict bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.strict = strict
+}
+
+// New creates and returns new instance of Money in a currency of r.
+func (r *Registry) New(amount int64, code CurrencyCode) (m Money, err error) {
+	currency, err := r.forNew(code)
+	if err != nil {
+		return m, err
+	}
+
+	return Money{
+		amount:   amount,
+		currency: currency,
+	}, nil
+}
+
+// forNew returns the currency for a new amount, honouring the strict mode.
+func (r *Registry) forNew(code CurrencyCode) (*Currency, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.currencies[code]
+	if !ok {
+		return nil, ErrCurrencyNotFound
+	}
+
+	if r.strict && c.Status == CurrencyWithdrawn {
+		if c.ReplacedBy != "" {
+			return c, fmt.Errorf("%w: %s, replaced by %s", ErrCurrencyWithdrawn, code, c.ReplacedBy)
+		}
+		return c, fmt.Errorf("%w: %s", ErrCurrencyWithdrawn, code)
+	}
+
+	return c, nil
+}
+
+func (r *Registry) ByCode(code CurrencyCode) (result Currency, err error) {
+	c, err := r.handle(code)
+	if err != nil {
+		return result, err
+	}
+
+	return *c, nil
+}
+
+// handle returns the handle of the currency with the given code, which
+// Money values point to.
+func (r *Registry) handle(code CurrencyCode) (*Currency, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.currencies[code]
+	if !ok {
+		return nil, ErrCurrencyNotFound
+	}
+
+	return c, nil
+}
+
+// ByCodeAt returns the currency with the given code if it was in use at t.
+// Otherwise the currency is returned together with ErrCurrencyNotInUse.
+func (r *Registry) ByCodeAt(code CurrencyCode, t time.Time) (result Currency, err error) {
+	c, err := r.ByCode(code)
+	if err != nil {
+		return c, err
+	}
+
+	if !c.InUseAt(t) {
+		return c, fmt.Errorf("%w: %s on %s", ErrCurrencyNotInUse, code, t.Format("2006-01-02"))
+	}
+
+	return c, nil
+}
+
+func (r *Registry) ByNumericCode(code string) (result Currency, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sc, ok := r.numeric[code]
+	if !ok {
+		return result, ErrCurrencyNotFound
+	}
+
+	return *r.currencies[sc], nil
+}
+
+// CurrenciesByCountry returns the currencies of r, withdrawn ones included,
+// that list the given ISO 3166-1 alpha-2 country code, sorted by code.
+func (r *Registry) CurrenciesByCountry(country string) []Currency {
+	return r.Filter(func(c Currency) bool {
+		return c.Countries.Contains(country)
+	})
+}
+
+// PrimaryCurrencyForCountry returns the currency that amounts in the given
+// country are usually denominated in today. See PrimaryCurrencyForCountryAt.
+func (r *Registry) PrimaryCurrencyForCountry(country string) (result Currency, err error) {
+	return r.PrimaryCurrencyForCountryAt(country, time.Now())
+}
+
+// PrimaryCurrencyForCountryAt returns the currency that amounts in the given
+// country were denominated in at t. Only ISO currencies, those with a
+// numeric code, that were in use at t are considered; a national currency
+// is preferred over one shared by more countries, so PA gives PAB rather
+// than USD. A currency replacing a national one, such as EUR replacing EEK,
+// only counts for that country from the day the national one was withdrawn.
+func (r *Registry) PrimaryCurrencyForCountryAt(country string, t time.Time) (result Currency, err error) {
+	cs := r.CurrenciesByCountry(country)
+	found := false
+	for _, c := range cs {
+		if c.NumericCode == "" || !c.InUseAt(t) || replacedAfter(cs, c.Code, t) {
+			continue
+		}
+
+		if !found || c.Countries.Len() < result.Countries.Len() {
+			result, found = c, true
+		}
+	}
+
+	if !found {
+		return result, fmt.Errorf("%w: no currency for country %q", ErrCurrencyNotFound, country)
+	}
+
+	return result, nil
+}
+
+// replacedAfter reports whether code replaced one of cs only after t.
+func replacedAfter(cs []Currency, code CurrencyCode, t time.Time) bool {
+	for _, c := range cs {
+		if c.ReplacedBy == code && !c.Withdrawn.IsZero() && t.Before(c.Withdrawn) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// List returns all currencies of r sorted by code.
+func (r *Registry) List() []Currency {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	cs := make([]Currency, len(r.sorted))
+	for i, c := range r.sorted {
+		cs[i] = *c
+	}
+
+	return cs
+}
+
+// Filter returns the currencies of r for which keep returns true, sorted by
+// code.
+func (r *Registry) Filter(keep func(Currency) bool) []Currency {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var cs []Currency
+	for _, c := range r.sorted {
+		if keep(*c) {
+			cs = append(cs, *c)
+		}
+	}
+
+	return cs
+}
+
+// Parse parses the text form of an amount, e.g. "1.00 USD", as produced by
+// Money.MarshalText.
+func (r *Registry) Parse(text string) (Money, error) {
+	return r.parseText([]byte(text))
+}
+
+// ParseJSON parses the JSON form of an amount as produced by Money.MarshalJSON.
+func (r *Registry) ParseJSON(b []byte) (Money, error) {
+	var ref moneyJSON
+	err := json.Unmarshal(b, &ref)
+	if err != nil {
+		return Money{}, err
+	}
+
+	currency, err := r.handle(ref.Currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{amount: ref.Amount, currency: currency}, nil
+}
+
+// Register adds c to r. Both its Code and its NumericCode, if set, must not
+// be registered yet.
+func (r *Registry) Register(c Currency) error {
+	if err := validateCurrency(&c); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.currencies[c.Code]; ok {
+		return fmt.Errorf("%w: code %s", ErrCurrencyExists, c.Code)
+	}
+
+	if other, ok := r.numeric[c.NumericCode]; ok && c.NumericCode != "" {
+		return fmt.Errorf("%w: numeric code %s is used by %s", ErrCurrencyExists, c.NumericCode, other)
+	}
+
+	r.currencies[c.Code] = &c
+	if c.NumericCode != "" {
+		r.numeric[c.NumericCode] = c.Code
+	}
+
+	i := sort.Search(len(r.sorted), func(i int) bool { return r.sorted[i].Code > c.Code })
+	r.sorted = append(r.sorted, nil)
+	copy(r.sorted[i+1:], r.sorted[i:])
+	r.sorted[i] = &c
+
+	return nil
+}
+
+// Update adds cs to r, replacing currencies with the same code. It is meant
+// for applying a table read by ReadCurrenciesJSON, ReadCurrenciesCSV or
+// ReadISO4217XML. Either all of cs are applied or, on error, none.
+func (r *Registry) Update(cs ...Currency) error {
+	cs = append([]Currency(nil), cs...)
+	for i := range cs {
+		if err := validateCurrency(&cs[i]); err != nil {
+			return err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	updated := make(map[CurrencyCode]*Currency, len(r.currencies)+len(cs))
+	for code, c := range r.currencies {
+		updated[code] = c
+	}
+	for _, c := range cs {
+		c := c
+		updated[c.Code] = &c
+	}
+
+	numeric := make(map[string]CurrencyCode, len(updated))
+	for _, c := range updated {
+		if c.NumericCode == "" {
+			continue
+		}
+		if other, ok := numeric[c.NumericCode]; ok {
+			return fmt.Errorf("%w: numeric code %s is used by %s and %s", ErrCurrencyExists, c.NumericCode, other, c.Code)
+		}
+		numeric[c.NumericCode] = c.Code
+	}
+
+	r.currencies = updated
+	r.reindex()
+
+	return nil
+}
+
+// Unregister removes the currency with the given code from r. Existing Money
+// values keep their currency.
+func (r *Registry) Unregister(code CurrencyCode) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	c, ok := r.currencies[code]
+	if !ok {
+		return ErrCurrencyNotFound
+	}
+
+	delete(r.currencies, code)
+	delete(r.numeric, c.NumericCode)
+
+	i := sort.Search(len(r.sorted), func(i int) bool { return r.sorted[i].Code >= code })
+	r.sorted = append(r.sorted[:i], r.sorted[i+1:]...)
+
+	return nil
+}
+
+// RegistryMoney wraps Money so that unmarshalling resolves the currency code
+// in Registry instead of the default registry. Registry must be set before
+// unmarshalling; nil stands for the default registry.
+type RegistryMoney struct {
+	Registry *Registry
+	Money    Money
+}
+
+func (rm *RegistryMoney) registry() *Registry {
+	if rm.Registry == nil {
+		return defaultRegistry
+	}
+
+	return rm.Registry
+}
+
+func (rm *RegistryMoney) UnmarshalJSON(b []byte) error {
+	money, err := rm.registry().ParseJSON(b)
+	if err != nil {
+		return err
+	}
+
+	rm.Money = money
+	return nil
+}
+
+func (rm *RegistryMoney) UnmarshalText(text []byte) error {
+	money, err := rm.registry().parseText(text)
+	if err != nil {
+		return err
+	}
+
+	rm.Money = money
+	return nil
+}
+
+func (rm RegistryMoney) MarshalJSON() ([]byte, error) {
+	return rm.Money.MarshalJSON()
+}
+
+func (rm RegistryMoney) MarshalText() ([]byte, error) {
+	return rm.Money.MarshalText()
+}
@@ -0,0 +1,262 @@
+package monies_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/Craftserve/monies"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryIsolation(t *testing.T) {
+	euro, err := monies.CurrencyByCode(monies.EUR)
+	require.NoError(t, err)
+
+	euro.Pattern, euro.Decimal, euro.Thousand = "#,##0.00 ¤", ",", " "
+	tenant, err := monies.NewRegistry(euro)
+	require.NoError(t, err)
+
+	m, err := tenant.New(123456, monies.EUR)
+	require.NoError(t, err)
+	assert.Equal(t, "1 234,56 €", m.String())
+	assert.Equal(t, "€1,234.56", monies.MustNew(123456, monies.EUR).String())
+
+	_, err = tenant.New(100, monies.USD)
+	assert.ErrorIs(t, err, monies.ErrCurrencyNotFound)
+
+	_, err = tenant.ByNumericCode("840")
+	assert.ErrorIs(t, err, monies.ErrCurrencyNotFound)
+
+	c, err := tenant.ByNumericCode("978")
+	require.NoError(t, err)
+	assert.Equal(t, euro, c)
+
+	credits := monies.Currency{Code: "XCR", Fraction: 2, Grapheme: "CR", Pattern: "#,##0.00 ¤", Decimal: "."}
+	require.NoError(t, tenant.Register(credits))
+	_, err = monies.CurrencyByCode("XCR")
+	assert.ErrorIs(t, err, monies.ErrCurrencyNotFound)
+
+	require.NoError(t, tenant.Unregister("XCR"))
+	assert.ErrorIs(t, tenant.Unregister("XCR"), monies.ErrCurrencyNotFound)
+}
+
+func TestNewRegistryErrors(t *testing.T) {
+	usd, err := monies.CurrencyByCode(monies.USD)
+	require.NoError(t, err)
+
+	_, err = monies.NewRegistry(usd, usd)
+	assert.ErrorIs(t, err, monies.ErrCurrencyExists)
+
+	_, err = monies.NewRegistry(monies.Currency{Code: "usd"})
+	assert.ErrorIs(t, err, monies.ErrInvalidCurrency)
+}
+
+func TestRegistryList(t *testing.T) {
+	list := monies.DefaultRegistry().List()
+	require.NotEmpty(t, list)
+	for i := 1; i < len(list); i++ {
+		assert.Less(t, list[i-1].Code, list[i].Code)
+	}
+
+	copied, err := monies.NewRegistry(list...)
+	require.NoError(t, err)
+	assert.Equal(t, list, copied.List())
+}
+
+func TestRegistryParse(t *testing.T) {
+	euro, err := monies.CurrencyByCode(monies.EUR)
+	require.NoError(t, err)
+
+	euro.Decimal = ","
+	tenant, err := monies.NewRegistry(euro)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		Name        string
+		Input       string
+		Expected    int64
+		ExpectedErr error
+	}{
+		{"SUCCESS", "12,34 EUR", 1234, nil},
+		{"NEGATIVE", "-0,05 EUR", -5, nil},
+		{"DEFAULT_DECIMAL", "12.34 EUR", 0, monies.ErrInvalidText},
+		{"UNKNOWN_CURRENCY", "12,34 USD", 0, monies.ErrCurrencyNotFound},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Name, func(t *testing.T) {
+			m, err := tenant.Parse(tC.Input)
+			assert.ErrorIs(t, err, tC.ExpectedErr)
+
+			if tC.ExpectedErr == nil {
+				assert.Equal(t, tC.Expected, m.Amount())
+				assert.Equal(t, euro, m.Currency())
+			}
+		})
+	}
+
+	m, err := tenant.ParseJSON([]byte(`{"amount": 100, "currency": "EUR"}`))
+	require.NoError(t, err)
+	assert.Equal(t, euro, m.Currency())
+
+	_, err = tenant.ParseJSON([]byte(`{"amount": 100, "currency": "USD"}`))
+	assert.ErrorIs(t, err, monies.ErrCurrencyNotFound)
+}
+
+func TestRegistryMoney(t *testing.T) {
+	credits := monies.Currency{Code: "XCR", Fraction: 2, Grapheme: "CR", Pattern: "#,##0.00 ¤", Decimal: "."}
+	tenant, err := monies.NewRegistry(credits)
+	require.NoError(t, err)
+
+	rm := monies.RegistryMoney{Registry: tenant}
+	require.NoError(t, json.Unmarshal([]byte(`{"amount": 150, "currency": "XCR"}`), &rm))
+	assert.Equal(t, "1.50 CR", rm.Money.String())
+
+	b, err := json.Marshal(rm)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"amount": 150, "currency": "XCR"}`, string(b))
+
+	text, err := rm.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "1.50 XCR", string(text))
+
+	rm = monies.RegistryMoney{Registry: tenant}
+	require.NoError(t, rm.UnmarshalText(text))
+	assert.Equal(t, int64(150), rm.Money.Amount())
+
+	var def monies.RegistryMoney
+	assert.ErrorIs(t, def.UnmarshalText(text), monies.ErrCurrencyNotFound)
+	require.NoError(t, def.UnmarshalText([]byte("1.50 USD")))
+	assert.Equal(t, monies.MustNew(150, monies.USD), def.Money)
+}
+
+func TestRegistryStrict(t *testing.T) {
+	r, err := monies.NewRegistry(monies.DefaultRegistry().List()...)
+	require.NoError(t, err)
+
+	_, err = r.New(100, monies.ZWD)
+	require.NoError(t, err)
+
+	r.SetStrict(true)
+	_, err = r.New(100, monies.ZWD)
+	assert.ErrorIs(t, err, monies.ErrCurrencyWithdrawn)
+	assert.Contains(t, err.Error(), "replaced by ZWL")
+	_, err = r.New(100, monies.PLN)
+	assert.NoError(t, err)
+
+	m, err := r.ParseJSON([]byte(`{"amount": 100, "currency": "ZWD"}`))
+	require.NoError(t, err)
+	assert.Equal(t, monies.ZWD, m.Currency().Code)
+	m, err = r.Parse("1.00 ZWD")
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), m.Amount())
+}
+
+func TestStrictCurrencies(t *testing.T) {
+	monies.SetStrictCurrencies(true)
+	t.Cleanup(func() { monies.SetStrictCurrencies(false) })
+
+	_, err := monies.New(100, monies.ZWD)
+	assert.ErrorIs(t, err, monies.ErrCurrencyWithdrawn)
+	_, err = monies.NewBig(big.NewInt(100), monies.ZWD)
+	assert.ErrorIs(t, err, monies.ErrCurrencyWithdrawn)
+
+	var m monies.Money
+	require.NoError(t, json.Unmarshal([]byte(`{"amount": 100, "currency": "ZWD"}`), &m))
+	assert.Equal(t, int64(100), m.Amount())
+	var bm monies.BigMoney
+	require.NoError(t, json.Unmarshal([]byte(`{"amount": 100, "currency": "ZWD"}`), &bm))
+	assert.Equal(t, "100", bm.Amount().String())
+}
+
+func TestRegistryNumericIndex(t *testing.T) {
+	r, err := monies.NewRegistry()
+	require.NoError(t, err)
+
+	credits := monies.Currency{Code: "XCR", NumericCode: "999", Fraction: 2, Pattern: "#,##0.00 ¤", Decimal: "."}
+	require.NoError(t, r.Register(credits))
+	c, err := r.ByNumericCode("999")
+	require.NoError(t, err)
+	assert.Equal(t, credits, c)
+
+	credits.NumericCode = "998"
+	require.NoError(t, r.Update(credits))
+	_, err = r.ByNumericCode("999")
+	assert.ErrorIs(t, err, monies.ErrCurrencyNotFound)
+	_, err = r.ByNumericCode("998")
+	assert.NoError(t, err)
+
+	require.NoError(t, r.Unregister("XCR"))
+	_, err = r.ByNumericCode("998")
+	assert.ErrorIs(t, err, monies.ErrCurrencyNotFound)
+	assert.Empty(t, r.List())
+}
+
+func TestRegistryUpdateKeepsEquality(t *testing.T) {
+	credits := monies.Currency{Code: "XCR", Fraction: 2, Pattern: "#,##0.00 ¤", Decimal: "."}
+	r, err := monies.NewRegistry(credits)
+	require.NoError(t, err)
+
+	before, err := r.New(100, "XCR")
+	require.NoError(t, err)
+
+	credits.Grapheme = "CR"
+	require.NoError(t, r.Update(credits))
+	after, err := r.New(100, "XCR")
+	require.NoError(t, err)
+
+	// == compares currency handles, Equals compares currency codes.
+	assert.False(t, before == after)
+	equal, err := before.Equals(after)
+	require.NoError(t, err)
+	assert.True(t, equal)
+	assert.Equal(t, "1.00 CR", after.String())
+}
+
+func TestRegistryLookupAllocations(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = monies.CurrencyByCode(monies.PLN)
+		_, _ = monies.CurrencyByNumericCode("985")
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestFilterCurrencies(t *testing.T) {
+	all := monies.AllCurrencies()
+	assert.Equal(t, monies.DefaultRegistry().List(), all)
+
+	all[0].Code = "XXX"
+	assert.Equal(t, monies.AED, monies.AllCurrencies()[0].Code)
+
+	three := monies.FilterCurrencies(monies.WithFraction(3))
+	codes := make([]monies.CurrencyCode, len(three))
+	for i, c := range three {
+		codes[i] = c.Code
+	}
+	assert.Equal(t, []monies.CurrencyCode{monies.BHD, monies.IQD, monies.JOD, monies.KWD, monies.LYD, monies.OMR, monies.TND}, codes)
+
+	assert.Empty(t, monies.FilterCurrencies(monies.WithFraction(5)))
+}
+
+func BenchmarkCurrencyByCode(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = monies.CurrencyByCode(monies.PLN)
+	}
+}
+
+func BenchmarkCurrencyByNumericCode(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = monies.CurrencyByNumericCode("985")
+	}
+}
+
+func BenchmarkAllCurrencies(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = monies.AllCurrencies()
+	}
+}
@@ -0,0 +1,105 @@
+package monies
+
+import (
+	"errors"
+	"math/big"
+)
+
+var ErrInvalidRoundingMode = errors.New("invalid rounding mode")
+
+// RoundingMode selects how a value lying between two representable amounts is rounded.
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to the nearest neighbour, ties away from zero.
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfDown rounds to the nearest neighbour, ties towards zero.
+	RoundHalfDown
+	// RoundHalfEven rounds to the nearest neighbour, ties to the even neighbour (banker's rounding).
+	RoundHalfEven
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundDown rounds towards zero.
+	RoundDown
+	// RoundCeiling rounds towards positive infinity.
+	RoundCeiling
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+)
+
+var roundingModeNames = [...]string{
+	RoundHalfUp:   "HalfUp",
+	RoundHalfDown: "HalfDown",
+	RoundHalfEven: "HalfEven",
+	RoundUp:       "Up",
+	RoundDown:     "Down",
+	RoundCeiling:  "Ceiling",
+	RoundFloor:    "Floor",
+}
+
+func (mode RoundingMode) String() string {
+	if !mode.valid() {
+		return "RoundingMode(?)"
+	}
+
+	return roundingModeNames[mode]
+}
+
+func (mode RoundingMode) valid() bool {
+	return mode >= RoundHalfUp && mode <= RoundFloor
+}
+
+// roundsAway reports whether a value truncated towards zero must be moved one
+// unit away from zero. neg is the sign of the exact value, half compares the
+// discarded (non-zero) fraction with one half and odd tells whether the
+// truncated value is odd.
+func (mode RoundingMode) roundsAway(neg bool, half int, odd bool) bool {
+	switch mode {
+	case RoundHalfUp:
+		return half >= 0
+	case RoundHalfDown:
+		return half > 0
+	case RoundHalfEven:
+		return half > 0 || half == 0 && odd
+	case RoundUp:
+		return true
+	case RoundCeiling:
+		return !neg
+	case RoundFloor:
+		return neg
+	}
+
+	return false
+}
+
+// RoundTo rounds m to the given number of fraction digits using mode.
+// Digits at or beyond the currency's Fraction leave m unchanged, negative
+// digits round to tens, hundreds and so on of major units.
+func (m Money) RoundTo(digits int, mode RoundingMode) (Money, error) {
+	if !mode.valid() {
+		return m, ErrInvalidRoundingMode
+	}
+
+	shift := m.cur().Fraction - digits
+	if shift <= 0 {
+		return m, nil
+	}
+
+	exp, ok := pow10(shift)
+	if !ok {
+		// The increment exceeds every int64, so the only representable result is zero.
+		q := quoRound(big.NewInt(m.amount), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(shift)), nil), mode)
+		if q.Sign() != 0 {
+			return m, ErrOverflow
+		}
+
+		return Money{amount: 0, currency: m.currency}, nil
+	}
+
+	a, ok := checkedMul(divRound(m.amount, exp, mode), exp)
+	if !ok {
+		return m, ErrOverflow
+	}
+
+	return Money{amount: a, currency: m.currency}, nil
+}
@@ -0,0 +1,92 @@
+package monies_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/Craftserve/monies"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoundingModes(t *testing.T) {
+	inputs := []int64{550, 250, 160, 110, 100, -100, -110, -160, -250, -550}
+	testCases := []struct {
+		Mode     monies.RoundingMode
+		Expected []int64
+	}{
+		{monies.RoundUp, []int64{6, 3, 2, 2, 1, -1, -2, -2, -3, -6}},
+		{monies.RoundDown, []int64{5, 2, 1, 1, 1, -1, -1, -1, -2, -5}},
+		{monies.RoundCeiling, []int64{6, 3, 2, 2, 1, -1, -1, -1, -2, -5}},
+		{monies.RoundFloor, []int64{5, 2, 1, 1, 1, -1, -2, -2, -3, -6}},
+		{monies.RoundHalfUp, []int64{6, 3, 2, 1, 1, -1, -1, -2, -3, -6}},
+		{monies.RoundHalfDown, []int64{5, 2, 2, 1, 1, -1, -1, -2, -2, -5}},
+		{monies.RoundHalfEven, []int64{6, 2, 2, 1, 1, -1, -1, -2, -2, -6}},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Mode.String(), func(t *testing.T) {
+			var rs []int64
+			for _, in := range inputs {
+				result, err := monies.MustNew(in, monies.EUR).RoundTo(0, tC.Mode)
+				assert.NoError(t, err)
+				rs = append(rs, result.Amount()/100)
+			}
+
+			assert.Equal(t, tC.Expected, rs)
+		})
+	}
+}
+
+func TestRoundTo(t *testing.T) {
+	testCases := []struct {
+		Money       monies.Money
+		Digits      int
+		Mode        monies.RoundingMode
+		Expected    int64
+		ExpectedErr error
+	}{
+		{monies.MustNew(12345, monies.EUR), 1, monies.RoundHalfUp, 12350, nil},
+		{monies.MustNew(12345, monies.EUR), 1, monies.RoundHalfEven, 12340, nil},
+		{monies.MustNew(12345, monies.EUR), 2, monies.RoundUp, 12345, nil},
+		{monies.MustNew(12345, monies.EUR), 5, monies.RoundUp, 12345, nil},
+		{monies.MustNew(12345, monies.EUR), -1, monies.RoundHalfUp, 12000, nil},
+		{monies.MustNew(-12345, monies.EUR), -2, monies.RoundFloor, -20000, nil},
+		{monies.MustNew(12345, monies.KWD), 2, monies.RoundHalfEven, 12340, nil},
+		{monies.MustNew(12345, monies.EUR), -20, monies.RoundDown, 0, nil},
+		{monies.MustNew(12345, monies.EUR), -20, monies.RoundUp, 0, monies.ErrOverflow},
+		{monies.MustNew(math.MaxInt64, monies.EUR), 0, monies.RoundCeiling, 0, monies.ErrOverflow},
+		{monies.MustNew(12345, monies.EUR), 0, monies.RoundingMode(42), 0, monies.ErrInvalidRoundingMode},
+	}
+
+	for index, tC := range testCases {
+		t.Run(fmt.Sprintf("#%d", index), func(t *testing.T) {
+			result, err := tC.Money.RoundTo(tC.Digits, tC.Mode)
+			assert.ErrorIs(t, err, tC.ExpectedErr)
+
+			if tC.ExpectedErr == nil {
+				assert.Equal(t, tC.Expected, result.Amount())
+			}
+		})
+	}
+}
+
+func TestRoundMatchesRoundHalfDown(t *testing.T) {
+	for _, amount := range []int64{-151, -150, -149, -50, 0, 49, 50, 51, 150, 250, math.MaxInt64 - 100} {
+		expected, err := monies.MustNew(amount, monies.EUR).RoundTo(0, monies.RoundHalfDown)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, monies.MustNew(amount, monies.EUR).Round())
+	}
+}
+
+func TestRoundSaturates(t *testing.T) {
+	max := monies.MustNew(math.MaxInt64, monies.KWD)
+	_, err := max.RoundTo(0, monies.RoundHalfDown)
+	assert.ErrorIs(t, err, monies.ErrOverflow)
+	assert.Equal(t, int64(9223372036854775000), max.Round().Amount())
+
+	min := monies.MustNew(math.MinInt64, monies.KWD)
+	_, err = min.RoundTo(0, monies.RoundHalfDown)
+	assert.ErrorIs(t, err, monies.ErrOverflow)
+	assert.Equal(t, int64(-9223372036854775000), min.Round().Amount())
+}
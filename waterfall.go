@@ -0,0 +1,90 @@
+package monies
+
+import "errors"
+
+var ErrInvalidTranche = errors.New("invalid tranche")
+
+// Tranche is a single priority level of a Waterfall.
+type Tranche struct {
+	// Name identifies the tranche in the result, e.g. "fees".
+	Name string
+	// Target is the amount the tranche absorbs before later tranches receive
+	// anything. A nil Target absorbs everything that reaches the tranche,
+	// which makes it a surplus bucket.
+	Target *Money
+	// Ratios optionally splits the tranche between its parties pro rata.
+	// Without ratios the tranche has a single party.
+	Ratios []int
+}
+
+// TrancheResult is the part of a payment received by a single Tranche.
+type TrancheResult struct {
+	Name string
+	// Amount is the total received by the tranche.
+	Amount Money
+	// Parties holds Amount allocated according to the tranche's Ratios.
+	Parties []Money
+	// Outstanding is the part of the Target the payment did not cover.
+	Outstanding Money
+}
+
+// WaterfallResult is a payment distributed by a Waterfall.
+type WaterfallResult struct {
+	Tranches []TrancheResult
+	// Remainder is the part of the payment no tranche absorbed.
+	Remainder Money
+}
+
+// Waterfall distributes payments over tranches in order of priority, e.g.
+// fees first, then interest, then principal. Each tranche is filled up to its
+// Target before the next one receives anything.
+type Waterfall struct {
+	Tranches []Tranche
+}
+
+// Distribute runs payment through the waterfall. The payment must not be
+// negative and every Target must be in the payment's currency. Amounts within
+// a tranche are split with Allocate, so no minor unit is lost.
+func (w Waterfall) Distribute(payment Money) (WaterfallResult, error) {
+	if payment.IsNegative() {
+		return WaterfallResult{}, ErrNegativeAmount
+	}
+
+	zero := Money{currency: payment.currency}
+	result := WaterfallResult{Tranches: make([]TrancheResult, 0, len(w.Tranches))}
+	remainder := payment
+	for _, t := range w.Tranches {
+		tr := TrancheResult{Name: t.Name, Amount: remainder, Outstanding: zero}
+		if t.Target != nil {
+			if err := payment.assertSameCurrency(*t.Target); err != nil {
+				return WaterfallResult{}, err
+			}
+
+			if t.Target.IsNegative() {
+				return WaterfallResult{}, ErrInvalidTranche
+			}
+
+			if t.Target.amount < remainder.amount {
+				tr.Amount = *t.Target
+			}
+			tr.Outstanding = Money{amount: t.Target.amount - tr.Amount.amount, currency: payment.currency}
+		}
+
+		if len(t.Ratios) == 0 {
+			tr.Parties = []Money{tr.Amount}
+		} else {
+			parties, err := tr.Amount.Allocate(t.Ratios...)
+			if err != nil {
+				return WaterfallResult{}, err
+			}
+			tr.Parties = parties
+		}
+
+		remainder.amount -= tr.Amount.amount
+		result.Tranches = append(result.Tranches, tr)
+	}
+
+	result.Remainder = remainder
+
+	return result, nil
+}
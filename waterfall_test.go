@@ -0,0 +1,115 @@
+package monies_test
+
+import (
+	"testing"
+
+	"github.com/Craftserve/monies"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func amounts(ms []monies.Money) []int64 {
+	var rs []int64
+	for _, m := range ms {
+		rs = append(rs, m.Amount())
+	}
+
+	return rs
+}
+
+func TestWaterfall(t *testing.T) {
+	w := monies.Waterfall{Tranches: []monies.Tranche{
+		{Name: "fees", Target: eur(500)},
+		{Name: "interest", Target: eur(1000), Ratios: []int{1, 1, 1}},
+		{Name: "principal", Target: eur(10000)},
+	}}
+
+	testCases := []struct {
+		Name                string
+		Payment             int64
+		ExpectedAmounts     []int64
+		ExpectedOutstanding []int64
+		ExpectedInterest    []int64
+		ExpectedRemainder   int64
+	}{
+		{"ZERO", 0, []int64{0, 0, 0}, []int64{500, 1000, 10000}, []int64{0, 0, 0}, 0},
+		{"FEES_ONLY", 300, []int64{300, 0, 0}, []int64{200, 1000, 10000}, []int64{0, 0, 0}, 0},
+		{"PARTIAL_INTEREST", 600, []int64{500, 100, 0}, []int64{0, 900, 10000}, []int64{34, 33, 33}, 0},
+		{"PARTIAL_PRINCIPAL", 5000, []int64{500, 1000, 3500}, []int64{0, 0, 6500}, []int64{334, 333, 333}, 0},
+		{"SURPLUS", 12000, []int64{500, 1000, 10000}, []int64{0, 0, 0}, []int64{334, 333, 333}, 500},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Name, func(t *testing.T) {
+			payment := monies.MustNew(tC.Payment, monies.EUR)
+			result, err := w.Distribute(payment)
+			require.NoError(t, err)
+			require.Len(t, result.Tranches, 3)
+
+			var got, outstanding []int64
+			total := result.Remainder
+			for _, tr := range result.Tranches {
+				got = append(got, tr.Amount.Amount())
+				outstanding = append(outstanding, tr.Outstanding.Amount())
+
+				var parties int64
+				for _, p := range tr.Parties {
+					parties += p.Amount()
+				}
+				assert.Equal(t, tr.Amount.Amount(), parties, tr.Name)
+
+				total, err = total.Add(tr.Amount)
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tC.ExpectedAmounts, got)
+			assert.Equal(t, tC.ExpectedOutstanding, outstanding)
+			assert.Equal(t, tC.ExpectedInterest, amounts(result.Tranches[1].Parties))
+			assert.Equal(t, tC.ExpectedRemainder, result.Remainder.Amount())
+			assert.Equal(t, payment, total)
+			assert.Equal(t, "interest", result.Tranches[1].Name)
+		})
+	}
+}
+
+func TestWaterfallSurplusBucket(t *testing.T) {
+	w := monies.Waterfall{Tranches: []monies.Tranche{
+		{Name: "fees", Target: eur(100)},
+		{Name: "surplus", Ratios: []int{70, 30}},
+	}}
+
+	result, err := w.Distribute(monies.MustNew(1101, monies.EUR))
+	require.NoError(t, err)
+
+	assert.Equal(t, []int64{701, 300}, amounts(result.Tranches[1].Parties))
+	assert.True(t, result.Tranches[1].Outstanding.IsZero())
+	assert.True(t, result.Remainder.IsZero())
+}
+
+func TestWaterfallErrors(t *testing.T) {
+	usd := monies.MustNew(100, monies.USD)
+
+	testCases := []struct {
+		Name        string
+		Waterfall   monies.Waterfall
+		Payment     monies.Money
+		ExpectedErr error
+	}{
+		{"NEGATIVE_PAYMENT", monies.Waterfall{}, monies.MustNew(-1, monies.EUR), monies.ErrNegativeAmount},
+		{"CURRENCY_MISMATCH", monies.Waterfall{Tranches: []monies.Tranche{{Target: &usd}}}, monies.MustNew(100, monies.EUR), monies.ErrCurrencyMismatch},
+		{"NEGATIVE_TARGET", monies.Waterfall{Tranches: []monies.Tranche{{Target: eur(-1)}}}, monies.MustNew(100, monies.EUR), monies.ErrInvalidTranche},
+		{"NEGATIVE_RATIO", monies.Waterfall{Tranches: []monies.Tranche{{Ratios: []int{1, -1}}}}, monies.MustNew(100, monies.EUR), monies.ErrNegativeRatio},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.Name, func(t *testing.T) {
+			_, err := tC.Waterfall.Distribute(tC.Payment)
+			assert.ErrorIs(t, err, tC.ExpectedErr)
+		})
+	}
+
+	result, err := monies.Waterfall{}.Distribute(monies.MustNew(100, monies.EUR))
+	require.NoError(t, err)
+	assert.Empty(t, result.Tranches)
+	assert.Equal(t, int64(100), result.Remainder.Amount())
+}